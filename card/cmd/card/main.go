@@ -16,6 +16,8 @@ import (
 	statClient "github.com/tomatoCoderq/card/internal/clients/stats/grpc"
 	"github.com/tomatoCoderq/card/internal/config"
 	"github.com/tomatoCoderq/card/internal/lib/security"
+	"github.com/tomatoCoderq/card/internal/services/outbox"
+	"github.com/tomatoCoderq/card/internal/services/trash"
 	"gopkg.in/yaml.v3"
 
 	app "github.com/tomatoCoderq/card/internal/app"
@@ -83,16 +85,32 @@ func main() {
 		ExpirationDelta: 600 * time.Minute,
 	}
 
-	application := app.New(log, cfg.GRPC.Port, cfg.ConnectionString, statClient, security) // ssoClient, statClient commented out
+	outboxConfig := outbox.Config{
+		Interval:    cfg.Outbox.Interval,
+		BatchSize:   cfg.Outbox.BatchSize,
+		MaxAttempts: cfg.Outbox.MaxAttempts,
+	}
+
+	trashConfig := trash.Config{
+		Retention:     cfg.Trash.Retention,
+		PurgeInterval: cfg.Trash.PurgeInterval,
+	}
+
+	application := app.New(log, cfg.GRPC.Port, cfg.ConnectionString, statClient, security, outboxConfig, trashConfig) // ssoClient, statClient commented out
 	go func() {
 		application.GRPCServer.MustRun()
 	}()
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	go application.Outbox.Run(jobsCtx)
+	go application.Trash.Run(jobsCtx)
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
 	<-stop
 
+	stopJobs()
 	application.GRPCServer.Stop()
 	log.Info("Gracefully stopped")
 
@@ -9,10 +9,14 @@ import (
 	"github.com/tomatoCoderq/card/internal/lib/security"
 	"github.com/tomatoCoderq/card/internal/repository/postgresql"
 	"github.com/tomatoCoderq/card/internal/services/card"
+	"github.com/tomatoCoderq/card/internal/services/outbox"
+	"github.com/tomatoCoderq/card/internal/services/trash"
 )
 
 type App struct {
 	GRPCServer *grpcapp.App
+	Outbox     *outbox.Dispatcher
+	Trash      *trash.Purger
 }
 
 func New(
@@ -21,13 +25,19 @@ func New(
 	storageAddress string,
 	statClient *statClient.Client,
 	security security.Security,
+	outboxConfig outbox.Config,
+	trashConfig trash.Config,
 ) *App {
 	storage := postgresql.New(storageAddress, log)
 
-	authService := services.New(log, storage, statClient)
+	authService := services.New(log, storage)
 	grpcApp := grpcapp.New(log, authService, grpcPort, statClient, security)
+	dispatcher := outbox.New(log, storage, statClient, &security, outboxConfig)
+	purger := trash.New(log, storage, trashConfig)
 
 	return &App{
 		GRPCServer: grpcApp,
+		Outbox:     dispatcher,
+		Trash:      purger,
 	}
 }
@@ -51,6 +51,10 @@ func New(log *slog.Logger,
 		security.AuthUnaryInterceptor(),
 		recovery.UnaryServerInterceptor(recoveryOpts...),
 		logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
+	), grpc.ChainStreamInterceptor(
+		security.AuthStreamInterceptor(),
+		recovery.StreamServerInterceptor(recoveryOpts...),
+		logging.StreamServerInterceptor(InterceptorLogger(log), loggingOpts...),
 	))
 
 	grpcCard.Register(gRPCServer, cardService, statClient)
@@ -123,29 +123,19 @@ func (c *Client) GetCardsReviewedCount(ctx context.Context, uid, deckId string,
 	return resp.ReviewedCount, nil
 }
 
-func (c *Client) AddRecord(ctx context.Context, deckId, cid string, grade int) (string, error) {
+// AddRecord adds review with the given id on behalf of the token owner. The
+// review id is the idempotency key, so retries don't duplicate the review
+func (c *Client) AddRecord(ctx context.Context, token, reviewId, deckId, cid string, createdAt time.Time, grade int) (string, error) {
 	const op = "grpc.AddRecord"
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return "", fmt.Errorf("missing metadata in context")
-	}
-
-	// Get the authorization token
-	authValues := md["authorization"]
-	if len(authValues) == 0 {
-		return "", fmt.Errorf("authorization token not found in metadata")
-	}
-	token := authValues[0]
+	ctx = withToken(ctx, token)
 
-	// Create new outgoing context with the token
-	outCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", token))
-
-	resp, err := c.api.AddRecording(outCtx, &statv1.AddRecordingRequest{
-		DeckId:    deckId,
-		CardId:    cid,
-		CreatedAt: timestamppb.New(time.Now()),
-		Grade:     int32(grade),
+	resp, err := c.api.AddRecording(ctx, &statv1.AddRecordingRequest{
+		DeckId:         deckId,
+		CardId:         cid,
+		CreatedAt:      timestamppb.New(createdAt),
+		Grade:          int32(grade),
+		IdempotencyKey: reviewId,
 	})
 
 	if err != nil {
@@ -154,3 +144,17 @@ func (c *Client) AddRecord(ctx context.Context, deckId, cid string, grade int) (
 
 	return resp.ReviewId, nil
 }
+
+func (c *Client) DeleteRecord(ctx context.Context, token, reviewId string) error {
+	const op = "grpc.DeleteRecord"
+
+	ctx = withToken(ctx, token)
+
+	_, err := c.api.DeleteRecording(ctx, &statv1.DeleteRecordingRequest{
+		ReviewId: reviewId,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -19,6 +19,21 @@ type Config struct {
 	Secret  string        `yaml:"secret" env-required:"true"`
 	Clients ClientsConfig `yaml:"clients"`
 	GRPC    GRPCConfig    `yaml:"grpc"`
+	Outbox  OutboxConfig  `yaml:"outbox"`
+	Trash   TrashConfig   `yaml:"trash"`
+}
+
+// TrashConfig sets how long deleted cards are kept before they are purged
+type TrashConfig struct {
+	Retention     time.Duration `yaml:"retention"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+// OutboxConfig tunes delivery of review events to stats service
+type OutboxConfig struct {
+	Interval    time.Duration `yaml:"interval"`
+	BatchSize   int           `yaml:"batch_size"`
+	MaxAttempts int           `yaml:"max_attempts"`
 }
 
 type GRPCConfig struct {
@@ -2,20 +2,42 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	// "github.com/tomatoCoderq/card/pkg/model"
 	"github.com/GOeda-Co/proto-contract/model/card"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 )
 
 type Card interface {
 	AddCard(card *model.Card) (*model.Card, error)
 	ReadAllOwnCardsToLearn(userId uuid.UUID) ([]model.Card, error)
-	ReadAllOwnCards(userId uuid.UUID) ([]model.Card, error)
-	SearchAllPublicCards() ([]model.Card, error)
-	SearchUserPublicCards(useId string) ([]model.Card, error)
+	ReadStudyQueue(userId uuid.UUID, deckId uuid.UUID, tag string) ([]model.Card, error)
+	ReadAllOwnCards(userId uuid.UUID, tag string, page pagination.Page) ([]model.Card, string, error)
+	SearchAllPublicCards(query string, page pagination.Page) ([]model.Card, string, error)
+	SearchUserPublicCards(useId string, query string, page pagination.Page) ([]model.Card, string, error)
 	UpdateCard(id uuid.UUID, card *schemes.UpdateCardScheme, userId uuid.UUID) (*model.Card, error)
 	DeleteCard(id uuid.UUID, userId uuid.UUID) error
-	AddAnswers(ctx context.Context, userId uuid.UUID, answers []schemes.AnswerScheme) error
+	ReadTrashedCards(userId uuid.UUID) ([]model.Card, error)
+	RestoreCard(cardId uuid.UUID, userId uuid.UUID) (*model.Card, error)
+	ReleaseDeckCards(deckId uuid.UUID, userId uuid.UUID, mode string, targetDeckId uuid.UUID, deletedAt time.Time) (int64, error)
+	ReadCardHistory(cardId uuid.UUID, userId uuid.UUID) ([]model.CardRevision, error)
+	RevertCard(cardId uuid.UUID, revision int, userId uuid.UUID) (*model.Card, error)
+	AddAnswers(ctx context.Context, userId uuid.UUID, answers []schemes.AnswerScheme) ([]schemes.AnswerResult, error)
+	SyncAnswers(ctx context.Context, userId uuid.UUID, answers []schemes.AnswerScheme) (*schemes.SyncResult, error)
+	UndoLastAnswer(ctx context.Context, userId uuid.UUID) (*model.Card, error)
+	ReadPreferences(userId uuid.UUID) (*model.Preference, error)
+	UpdatePreferences(userId uuid.UUID, algorithm string) (*model.Preference, error)
+	RetagCards(cardIds []uuid.UUID, add, remove []string, userId uuid.UUID) ([]model.Card, error)
+	ReadTags(userId uuid.UUID) ([]model.TagCount, error)
+	RenameTag(tag string, newName string, userId uuid.UUID) (int64, error)
+	CloneDeckCards(sourceDeckId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) (int, error)
+	PublishDeck(deckId uuid.UUID, userId uuid.UUID) (*schemes.PublishResult, error)
+	ReadDeckChanges(deckId uuid.UUID, sinceVersion int, userId uuid.UUID) ([]model.DeckChange, int, error)
+	SyncDeck(deckId uuid.UUID, userId uuid.UUID) (*schemes.DeckSyncResult, error)
+	SetKeepLocal(cardId uuid.UUID, keepLocal bool, userId uuid.UUID) (*model.Card, error)
+	ImportCards(deckId uuid.UUID, userId uuid.UUID, next func() ([]schemes.ImportRow, error)) (*schemes.ImportResult, error)
+	ReadDeckReviews(deckId uuid.UUID, userId uuid.UUID) ([]schemes.Review, error)
 }
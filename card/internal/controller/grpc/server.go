@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/GOeda-Co/proto-contract/convert"
 	cardv1 "github.com/GOeda-Co/proto-contract/gen/go/card"
+	model "github.com/GOeda-Co/proto-contract/model/card"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 	"github.com/google/uuid"
 	statClient "github.com/tomatoCoderq/card/internal/clients/stats/grpc"
 	"github.com/tomatoCoderq/card/internal/controller"
+	"github.com/tomatoCoderq/card/internal/lib/scheduler"
 	"github.com/tomatoCoderq/card/internal/lib/security"
+	services "github.com/tomatoCoderq/card/internal/services/card"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,7 +57,8 @@ func (s *ServerAPI) AddCard(ctx context.Context, in *cardv1.AddCardRequest) (*ca
 	if in.Card.Word == "" {
 		return nil, status.Error(codes.InvalidArgument, "Word is required")
 	}
-	if in.Card.Translation == "" {
+	// Translation of a cloze card is only extra text for its back
+	if in.Card.Translation == "" && in.Card.Template != model.TemplateCloze {
 		return nil, status.Error(codes.InvalidArgument, "Translation is required")
 	}
 
@@ -62,7 +69,7 @@ func (s *ServerAPI) AddCard(ctx context.Context, in *cardv1.AddCardRequest) (*ca
 
 	fullCard, err := s.service.AddCard(card)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed during adding card")
+		return nil, cardError(err, "Failed during adding card")
 	}
 
 	return &cardv1.AddCardResponse{Card: convert.FromModelToProtoCard(fullCard)}, nil
@@ -87,15 +94,47 @@ func (s *ServerAPI) ReadAllOwnCardsToLearn(ctx context.Context, in *emptypb.Empt
 	return &cardv1.ReadAllCardsToLearnResponse{Cards: protoCards}, nil
 }
 
-func (s *ServerAPI) ReadAllOwnCards(ctx context.Context, in *emptypb.Empty) (*cardv1.ReadAllOwnCardsResponse, error) {
+func (s *ServerAPI) ReadStudyQueue(ctx context.Context, in *cardv1.ReadStudyQueueRequest) (*cardv1.ReadAllCardsToLearnResponse, error) {
 	authUser, err := GetAuthUser(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
 	}
 
-	cards, err := s.service.ReadAllOwnCards(authUser.ID)
+	deckId := uuid.Nil
+	if in.DeckId != "" {
+		deckId, err = uuid.Parse(in.DeckId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+		}
+	}
+
+	cards, err := s.service.ReadStudyQueue(authUser.ID, deckId, in.Tag)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to read cards")
+		return nil, cardError(err, "Failed to read study queue")
+	}
+
+	var protoCards []*cardv1.Card
+	for _, card := range cards {
+		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
+	}
+
+	return &cardv1.ReadAllCardsToLearnResponse{Cards: protoCards}, nil
+}
+
+func (s *ServerAPI) ReadAllOwnCards(ctx context.Context, in *cardv1.ReadAllOwnCardsRequest) (*cardv1.ReadAllOwnCardsResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cards, next, err := s.service.ReadAllOwnCards(authUser.ID, in.Tag, page)
+	if err != nil {
+		return nil, cardError(err, "Failed to read cards")
 	}
 
 	var protoCards []*cardv1.Card
@@ -103,11 +142,16 @@ func (s *ServerAPI) ReadAllOwnCards(ctx context.Context, in *emptypb.Empty) (*ca
 		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
 	}
 
-	return &cardv1.ReadAllOwnCardsResponse{Cards: protoCards}, nil
+	return &cardv1.ReadAllOwnCardsResponse{Cards: protoCards, NextCursor: next}, nil
 }
 
-func (s *ServerAPI) SearchAllPublicCards(ctx context.Context, in *emptypb.Empty) (*cardv1.SearchAllPublicCardsResponse, error) {
-	cards, err := s.service.SearchAllPublicCards()
+func (s *ServerAPI) SearchAllPublicCards(ctx context.Context, in *cardv1.SearchPublicCardsRequest) (*cardv1.SearchAllPublicCardsResponse, error) {
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cards, next, err := s.service.SearchAllPublicCards(in.Query, page)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to search public cards")
 	}
@@ -117,11 +161,16 @@ func (s *ServerAPI) SearchAllPublicCards(ctx context.Context, in *emptypb.Empty)
 		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
 	}
 
-	return &cardv1.SearchAllPublicCardsResponse{Cards: protoCards}, nil
+	return &cardv1.SearchAllPublicCardsResponse{Cards: protoCards, NextCursor: next}, nil
 }
 
 func (s *ServerAPI) SearchUserPublicCards(ctx context.Context, in *cardv1.SearchUserPublicCardsRequest) (*cardv1.SearchUserPublicCardsResponse, error) {
-	cards, err := s.service.SearchUserPublicCards(in.UserId)
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cards, next, err := s.service.SearchUserPublicCards(in.UserId, in.Query, page)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to search user public cards")
 	}
@@ -131,7 +180,7 @@ func (s *ServerAPI) SearchUserPublicCards(ctx context.Context, in *cardv1.Search
 		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
 	}
 
-	return &cardv1.SearchUserPublicCardsResponse{Cards: protoCards}, nil
+	return &cardv1.SearchUserPublicCardsResponse{Cards: protoCards, NextCursor: next}, nil
 }
 
 func (s *ServerAPI) UpdateCard(ctx context.Context, in *cardv1.UpdateCardRequest) (*cardv1.UpdateCardResponse, error) {
@@ -151,7 +200,7 @@ func (s *ServerAPI) UpdateCard(ctx context.Context, in *cardv1.UpdateCardRequest
 
 	updatedCard, err := s.service.UpdateCard(cardId, cardUpdate, authUser.ID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to update card")
+		return nil, cardError(err, "Failed to update card")
 	}
 
 	return &cardv1.UpdateCardResponse{Card: convert.FromModelToProtoCard(updatedCard)}, nil
@@ -169,12 +218,377 @@ func (s *ServerAPI) DeleteCard(ctx context.Context, in *cardv1.DeleteCardRequest
 
 	err = s.service.DeleteCard(cardId, authUser.ID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to delete card: %v", err))
+		return nil, cardError(err, "Failed to delete card")
 	}
 
 	return &cardv1.DeleteCardResponse{}, nil
 }
 
+func (s *ServerAPI) ReadTrashedCards(ctx context.Context, in *emptypb.Empty) (*cardv1.TrashedCardsResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	cards, err := s.service.ReadTrashedCards(authUser.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to read trashed cards")
+	}
+
+	var protoCards []*cardv1.Card
+	for _, card := range cards {
+		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
+	}
+
+	return &cardv1.TrashedCardsResponse{Cards: protoCards}, nil
+}
+
+func (s *ServerAPI) RestoreCard(ctx context.Context, in *cardv1.RestoreCardRequest) (*cardv1.RestoreCardResponse, error) {
+	cardId, err := uuid.Parse(in.CardId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+	}
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	card, err := s.service.RestoreCard(cardId, authUser.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrDeckInTrash) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, cardError(err, "Failed to restore card")
+	}
+
+	return &cardv1.RestoreCardResponse{Card: convert.FromModelToProtoCard(card)}, nil
+}
+
+func (s *ServerAPI) ReleaseDeckCards(ctx context.Context, in *cardv1.ReleaseDeckCardsRequest) (*cardv1.ReleaseDeckCardsResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+	var targetDeckId uuid.UUID
+	if in.TargetDeckId != "" {
+		if targetDeckId, err = uuid.Parse(in.TargetDeckId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid target deck ID")
+		}
+	}
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	var deletedAt time.Time
+	if in.DeletedAt != nil {
+		deletedAt = in.DeletedAt.AsTime()
+	}
+
+	count, err := s.service.ReleaseDeckCards(deckId, authUser.ID, in.Mode, targetDeckId, deletedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDeleteMode), errors.Is(err, services.ErrInvalidTargetDeck):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, services.ErrDeckNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, services.ErrNotDeckOwner):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to release deck cards: %v", err))
+	}
+
+	return &cardv1.ReleaseDeckCardsResponse{CardsCount: int32(count)}, nil
+}
+
+func (s *ServerAPI) CloneDeckCards(ctx context.Context, in *cardv1.CloneDeckCardsRequest) (*cardv1.CloneDeckCardsResponse, error) {
+	sourceDeckId, err := uuid.Parse(in.SourceDeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid source deck ID")
+	}
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	count, err := s.service.CloneDeckCards(sourceDeckId, deckId, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to clone deck cards")
+	}
+
+	return &cardv1.CloneDeckCardsResponse{CardsCount: int32(count)}, nil
+}
+
+func (s *ServerAPI) PublishDeck(ctx context.Context, in *cardv1.PublishDeckRequest) (*cardv1.PublishDeckResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	result, err := s.service.PublishDeck(deckId, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to publish deck")
+	}
+
+	return convert.FromPublishResultToProto(result), nil
+}
+
+func (s *ServerAPI) ReadDeckChanges(ctx context.Context, in *cardv1.ReadDeckChangesRequest) (*cardv1.ReadDeckChangesResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	changes, version, err := s.service.ReadDeckChanges(deckId, int(in.SinceVersion), authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to read deck changes")
+	}
+
+	return &cardv1.ReadDeckChangesResponse{Version: int32(version), Changes: convert.FromDeckChangesToProto(changes)}, nil
+}
+
+func (s *ServerAPI) SyncDeck(ctx context.Context, in *cardv1.SyncDeckRequest) (*cardv1.SyncDeckResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	result, err := s.service.SyncDeck(deckId, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to sync deck")
+	}
+
+	return convert.FromDeckSyncResultToProto(result), nil
+}
+
+func (s *ServerAPI) SetKeepLocal(ctx context.Context, in *cardv1.SetKeepLocalRequest) (*cardv1.SetKeepLocalResponse, error) {
+	cardId, err := uuid.Parse(in.CardId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	card, err := s.service.SetKeepLocal(cardId, in.KeepLocal, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to update card")
+	}
+
+	return &cardv1.SetKeepLocalResponse{Card: convert.FromModelToProtoCard(card)}, nil
+}
+
+func (s *ServerAPI) ReadCardHistory(ctx context.Context, in *cardv1.ReadCardHistoryRequest) (*cardv1.ReadCardHistoryResponse, error) {
+	cardId, err := uuid.Parse(in.CardId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+	}
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	revisions, err := s.service.ReadCardHistory(cardId, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to read card history")
+	}
+
+	var protoRevisions []*cardv1.CardRevision
+	for _, revision := range revisions {
+		protoRevisions = append(protoRevisions, convert.FromModelToProtoCardRevision(&revision))
+	}
+
+	return &cardv1.ReadCardHistoryResponse{Revisions: protoRevisions}, nil
+}
+
+func (s *ServerAPI) RevertCard(ctx context.Context, in *cardv1.RevertCardRequest) (*cardv1.RevertCardResponse, error) {
+	cardId, err := uuid.Parse(in.CardId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+	}
+	if in.Revision < 1 {
+		return nil, status.Error(codes.InvalidArgument, "Revision must be positive")
+	}
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	card, err := s.service.RevertCard(cardId, int(in.Revision), authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to revert card")
+	}
+
+	return &cardv1.RevertCardResponse{Card: convert.FromModelToProtoCard(card)}, nil
+}
+
+func (s *ServerAPI) RetagCards(ctx context.Context, in *cardv1.RetagCardsRequest) (*cardv1.RetagCardsResponse, error) {
+	cardIds := make([]uuid.UUID, 0, len(in.CardIds))
+	for _, id := range in.CardIds {
+		cardId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+		}
+		cardIds = append(cardIds, cardId)
+	}
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	cards, err := s.service.RetagCards(cardIds, in.Add, in.Remove, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to retag cards")
+	}
+
+	var protoCards []*cardv1.Card
+	for _, card := range cards {
+		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
+	}
+
+	return &cardv1.RetagCardsResponse{Cards: protoCards}, nil
+}
+
+func (s *ServerAPI) ReadTags(ctx context.Context, in *emptypb.Empty) (*cardv1.ReadTagsResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	tags, err := s.service.ReadTags(authUser.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to read tags")
+	}
+
+	return &cardv1.ReadTagsResponse{Tags: convert.FromModelToProtoTagCounts(tags)}, nil
+}
+
+func (s *ServerAPI) RenameTag(ctx context.Context, in *cardv1.RenameTagRequest) (*cardv1.RenameTagResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	count, err := s.service.RenameTag(in.Tag, in.NewName, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to rename tag")
+	}
+
+	return &cardv1.RenameTagResponse{CardsCount: count}, nil
+}
+
+// ImportCards reads the deck from the first message and imports rows of all messages
+// as they arrive, answering once the client closes the stream
+func (s *ServerAPI) ImportCards(stream grpc.ClientStreamingServer[cardv1.ImportCardsRequest, cardv1.ImportCardsResponse]) error {
+	authUser, err := GetAuthUser(stream.Context())
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "Deck ID is required")
+	}
+	if err != nil {
+		return err
+	}
+	deckId, err := uuid.Parse(first.DeckId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	pending := first.Rows
+	next := func() ([]schemes.ImportRow, error) {
+		if pending != nil {
+			rows, err := convert.FromProtoToImportRows(pending)
+			pending = nil
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return rows, nil
+		}
+		in, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := convert.FromProtoToImportRows(in.Rows)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return rows, nil
+	}
+
+	result, err := s.service.ImportCards(deckId, authUser.ID, next)
+	if err != nil {
+		// Failures of the stream itself already carry a status
+		if st, ok := status.FromError(err); ok {
+			return st.Err()
+		}
+		return cardError(err, "Failed to import cards")
+	}
+
+	return stream.SendAndClose(convert.FromImportResultToProto(result))
+}
+
+func (s *ServerAPI) ReadDeckReviews(ctx context.Context, in *cardv1.ReadDeckReviewsRequest) (*cardv1.ReadDeckReviewsResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	reviews, err := s.service.ReadDeckReviews(deckId, authUser.ID)
+	if err != nil {
+		return nil, cardError(err, "Failed to read deck reviews")
+	}
+
+	return &cardv1.ReadDeckReviewsResponse{Reviews: convert.FromReviewsToProto(reviews)}, nil
+}
+
+// cardError maps errors of operations on a single own card to gRPC statuses
+func cardError(err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrCardNotFound), errors.Is(err, services.ErrRevisionNotFound),
+		errors.Is(err, services.ErrDeckNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrNotCardOwner), errors.Is(err, services.ErrNotDeckOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, services.ErrInvalidTag), errors.Is(err, services.ErrNoCardsSelected),
+		errors.Is(err, services.ErrTooManyRows), errors.Is(err, services.ErrNotClonedCard),
+		errors.Is(err, services.ErrInvalidTemplate), errors.Is(err, services.ErrInvalidCloze):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrNotSubscribed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("%s: %v", message, err))
+}
+
 func (s *ServerAPI) AddAnswers(ctx context.Context, in *cardv1.AddAnswersRequest) (*cardv1.AddAnswersResponse, error) {
 	var answers []schemes.AnswerScheme
 
@@ -197,10 +611,103 @@ func (s *ServerAPI) AddAnswers(ctx context.Context, in *cardv1.AddAnswersRequest
 		answers = append(answers, *answerConverted)
 	}
 
-	err = s.service.AddAnswers(ctx, authUser.ID, answers)
+	results, err := s.service.AddAnswers(ctx, authUser.ID, answers)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to add answers: %v", err))
 	}
 
-	return &cardv1.AddAnswersResponse{Message: "added answers successfully"}, nil
+	return &cardv1.AddAnswersResponse{
+		Message: "added answers successfully",
+		Results: convert.FromAnswerResultsToProto(results),
+	}, nil
+}
+
+func (s *ServerAPI) SyncAnswers(ctx context.Context, in *cardv1.SyncAnswersRequest) (*cardv1.SyncAnswersResponse, error) {
+	var answers []schemes.AnswerScheme
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	for _, answer := range in.Answers {
+		if answer.CardId == "" {
+			return nil, status.Error(codes.InvalidArgument, "Card ID is required in answers")
+		}
+		if answer.Grade < 0 || answer.Grade > 5 {
+			return nil, status.Error(codes.InvalidArgument, "Grade must be between 0 and 5")
+		}
+		answerConverted, err := convert.FromProtoToAnswerSchemeCard(answer)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid card ID in answers")
+		}
+		answers = append(answers, *answerConverted)
+	}
+
+	result, err := s.service.SyncAnswers(ctx, authUser.ID, answers)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidAnswerTime) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to sync answers: %v", err))
+	}
+
+	var protoCards []*cardv1.Card
+	for _, card := range result.Cards {
+		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
+	}
+
+	return &cardv1.SyncAnswersResponse{
+		Cards:     protoCards,
+		Results:   convert.FromAnswerResultsToProto(result.Results),
+		Conflicts: convert.FromSyncConflictsToProto(result.Conflicts),
+	}, nil
+}
+
+func (s *ServerAPI) UndoLastAnswer(ctx context.Context, in *emptypb.Empty) (*cardv1.UndoLastAnswerResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	card, err := s.service.UndoLastAnswer(ctx, authUser.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrNothingToUndo) || errors.Is(err, services.ErrCardNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to undo answer: %v", err))
+	}
+
+	return &cardv1.UndoLastAnswerResponse{Card: convert.FromModelToProtoCard(card)}, nil
+}
+
+func (s *ServerAPI) ReadPreferences(ctx context.Context, in *emptypb.Empty) (*cardv1.PreferencesResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	preference, err := s.service.ReadPreferences(authUser.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to read preferences")
+	}
+
+	return &cardv1.PreferencesResponse{Preferences: convert.FromModelToProtoPreferences(preference)}, nil
+}
+
+func (s *ServerAPI) UpdatePreferences(ctx context.Context, in *cardv1.UpdatePreferencesRequest) (*cardv1.PreferencesResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	preference, err := s.service.UpdatePreferences(authUser.ID, in.Algorithm)
+	if errors.Is(err, scheduler.ErrUnknownAlgorithm) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update preferences")
+	}
+
+	return &cardv1.PreferencesResponse{Preferences: convert.FromModelToProtoPreferences(preference)}, nil
 }
@@ -0,0 +1,128 @@
+package fsrs
+
+import (
+	"math"
+	"time"
+)
+
+// Default FSRS-4.5 weights, see https://github.com/open-spaced-repetition/fsrs4anki/wiki/The-Algorithm
+var DefaultWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206,
+	5.1618, 1.2298, 0.8975, 0.031,
+	1.6474, 0.1367, 1.0461, 2.1072,
+	0.0793, 0.3246, 1.587, 0.2272,
+	2.8755,
+}
+
+const (
+	decay  = -0.5
+	factor = 19.0 / 81.0
+
+	DefaultRetention = 0.9
+	MaxInterval      = 36500 // in days
+
+	minDifficulty = 1.0
+	maxDifficulty = 10.0
+)
+
+// Rating is the FSRS four-button scale
+type Rating int
+
+const (
+	Again Rating = iota + 1
+	Hard
+	Good
+	Easy
+)
+
+// RatingFromGrade maps 0–5 SM-2 grade onto FSRS rating
+func RatingFromGrade(grade int) Rating {
+	switch {
+	case grade < 3:
+		return Again
+	case grade == 3:
+		return Hard
+	case grade == 4:
+		return Good
+	default:
+		return Easy
+	}
+}
+
+type ReviewResult struct {
+	NextReviewTime time.Time
+	Interval       int // in days
+	Stability      float64
+	Difficulty     float64
+}
+
+func FSRS(
+	now time.Time,
+	stability float64,
+	difficulty float64,
+	lastReview *time.Time,
+	grade int, // 0–5 scale
+) ReviewResult {
+	w := DefaultWeights
+	rating := RatingFromGrade(grade)
+
+	if stability <= 0 || lastReview == nil {
+		// first review of the card: initial memory state depends only on rating
+		stability = w[rating-1]
+		difficulty = clampDifficulty(initDifficulty(w, rating))
+	} else {
+		elapsed := math.Max(now.Sub(*lastReview).Hours()/24, 0)
+		r := Retrievability(elapsed, stability)
+
+		next := difficulty - w[6]*float64(rating-3)
+		difficulty = clampDifficulty(w[7]*initDifficulty(w, Easy) + (1-w[7])*next)
+
+		if rating == Again {
+			stability = w[11] *
+				math.Pow(difficulty, -w[12]) *
+				(math.Pow(stability+1, w[13]) - 1) *
+				math.Exp(w[14]*(1-r))
+		} else {
+			hardPenalty, easyBonus := 1.0, 1.0
+			if rating == Hard {
+				hardPenalty = w[15]
+			}
+			if rating == Easy {
+				easyBonus = w[16]
+			}
+			stability *= 1 + math.Exp(w[8])*
+				(11-difficulty)*
+				math.Pow(stability, -w[9])*
+				(math.Exp(w[10]*(1-r))-1)*
+				hardPenalty*easyBonus
+		}
+	}
+
+	interval := NextInterval(stability, DefaultRetention)
+
+	return ReviewResult{
+		NextReviewTime: now.Add(time.Duration(interval) * 24 * time.Hour),
+		Interval:       interval,
+		Stability:      stability,
+		Difficulty:     difficulty,
+	}
+}
+
+// Retrievability is the probability of recall after elapsed days with given stability
+func Retrievability(elapsedDays, stability float64) float64 {
+	return math.Pow(1+factor*elapsedDays/stability, decay)
+}
+
+// NextInterval returns the interval in days at which recall probability drops to retention
+func NextInterval(stability, retention float64) int {
+	interval := stability / factor * (math.Pow(retention, 1/decay) - 1)
+	return int(math.Min(math.Max(math.Round(interval), 1), MaxInterval))
+}
+
+func initDifficulty(w [17]float64, rating Rating) float64 {
+	return w[4] - float64(rating-3)*w[5]
+}
+
+func clampDifficulty(d float64) float64 {
+	return math.Min(math.Max(d, minDifficulty), maxDifficulty)
+}
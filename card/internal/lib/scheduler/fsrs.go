@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/tomatoCoderq/card/internal/lib/fsrs"
+)
+
+type fsrsMemory struct{}
+
+func (fsrsMemory) algorithm() Algorithm {
+	return FSRS
+}
+
+// answer updates stability and difficulty on every answer, including (re)learning steps
+func (fsrsMemory) answer(now time.Time, state State, grade int) State {
+	review := fsrs.FSRS(now, state.Stability, state.Difficulty, state.LastReview, grade)
+
+	next := state
+	next.Stability = review.Stability
+	next.Difficulty = review.Difficulty
+	if grade < 3 {
+		next.Repetitions = 0
+	} else {
+		next.Repetitions++
+	}
+	return next
+}
+
+func (fsrsMemory) interval(next State, grade int) int {
+	return fsrs.NextInterval(next.Stability, fsrs.DefaultRetention)
+}
+
+func (fsrsMemory) graduate(previous, next State, easy bool) State {
+	next.Interval = fsrs.NextInterval(next.Stability, fsrs.DefaultRetention)
+	return next
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+)
+
+type Algorithm string
+
+const (
+	SM2  Algorithm = "sm2"
+	FSRS Algorithm = "fsrs"
+
+	Default = SM2
+)
+
+// Phase of the card in the learning cycle
+type Phase string
+
+const (
+	PhaseNew        Phase = "new"
+	PhaseLearning   Phase = "learning"
+	PhaseReview     Phase = "review"
+	PhaseRelearning Phase = "relearning"
+)
+
+var ErrUnknownAlgorithm = errors.New("unknown scheduling algorithm")
+
+// State is the per-card scheduling state. Every algorithm reads and writes
+// only the fields it needs, so a card can be switched between algorithms
+type State struct {
+	Phase       Phase
+	Step        int
+	Interval    int // in days
+	Easiness    float64
+	Repetitions int
+	Stability   float64
+	Difficulty  float64
+	Lapses      int
+	LastReview  *time.Time
+}
+
+type Result struct {
+	State
+	NextReviewTime time.Time
+}
+
+// Config holds Anki-style step settings shared by all algorithms
+type Config struct {
+	LearningSteps      []time.Duration
+	RelearningSteps    []time.Duration
+	GraduatingInterval int // in days
+	EasyInterval       int // in days
+	MaxInterval        int // in days
+}
+
+func DefaultConfig() Config {
+	return Config{
+		LearningSteps:      []time.Duration{time.Minute, 10 * time.Minute},
+		RelearningSteps:    []time.Duration{10 * time.Minute},
+		GraduatingInterval: 1,
+		EasyInterval:       4,
+		MaxInterval:        36500,
+	}
+}
+
+type Scheduler interface {
+	Algorithm() Algorithm
+	Schedule(now time.Time, state State, grade int) Result
+}
+
+// Parse validates algorithm name. Empty name falls back to Default
+func Parse(name string) (Algorithm, error) {
+	switch Algorithm(name) {
+	case "":
+		return Default, nil
+	case SM2, FSRS:
+		return Algorithm(name), nil
+	default:
+		return "", ErrUnknownAlgorithm
+	}
+}
+
+func New(algorithm Algorithm, cfg Config) (Scheduler, error) {
+	switch algorithm {
+	case SM2:
+		return stepScheduler{cfg: cfg, memory: sm2Memory{cfg: cfg}}, nil
+	case FSRS:
+		return stepScheduler{cfg: cfg, memory: fsrsMemory{}}, nil
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+}
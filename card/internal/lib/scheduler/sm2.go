@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/tomatoCoderq/card/internal/lib/sm2"
+)
+
+type sm2Memory struct {
+	cfg Config
+}
+
+func (sm2Memory) algorithm() Algorithm {
+	return SM2
+}
+
+// answer runs SM-2 for review cards only, (re)learning steps don't change easiness
+func (sm2Memory) answer(now time.Time, state State, grade int) State {
+	if state.Phase != PhaseReview {
+		return state
+	}
+
+	review := sm2.SM2(now, state.Interval, state.Easiness, state.Repetitions, grade)
+
+	next := state
+	next.Interval = review.Interval
+	next.Easiness = review.Easiness
+	next.Repetitions = review.Repetitions
+	return next
+}
+
+func (sm2Memory) interval(next State, grade int) int {
+	return next.Interval
+}
+
+func (m sm2Memory) graduate(previous, next State, easy bool) State {
+	// Relearned card keeps the interval SM-2 gave it on lapse
+	if previous.Phase == PhaseReview || previous.Phase == PhaseRelearning {
+		return next
+	}
+
+	next.Repetitions = 1
+	next.Interval = m.cfg.GraduatingInterval
+	if easy {
+		next.Interval = m.cfg.EasyInterval
+	}
+	return next
+}
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"time"
+)
+
+// memory is the algorithm specific part of a scheduler
+type memory interface {
+	algorithm() Algorithm
+	// answer updates memory state of the card, it is called for every answer
+	answer(now time.Time, state State, grade int) State
+	// interval returns review interval in days of a recalled review card
+	interval(next State, grade int) int
+	// graduate sets interval in days of a card leaving (re)learning
+	graduate(previous, next State, easy bool) State
+}
+
+// stepScheduler moves cards through learning and relearning steps and lets
+// memory decide intervals of review cards
+type stepScheduler struct {
+	cfg    Config
+	memory memory
+}
+
+func (s stepScheduler) Algorithm() Algorithm {
+	return s.memory.algorithm()
+}
+
+func (s stepScheduler) Schedule(now time.Time, state State, grade int) Result {
+	next := s.memory.answer(now, state, grade)
+	next.LastReview = &now
+
+	switch state.Phase {
+	case PhaseReview:
+		if grade >= 3 {
+			next.Interval = s.capInterval(s.memory.interval(next, grade))
+			return Result{State: next, NextReviewTime: now.AddDate(0, 0, next.Interval)}
+		}
+
+		next.Lapses++
+		next.Phase = PhaseRelearning
+		next.Step = 0
+		return s.step(now, state, next, grade, s.cfg.RelearningSteps)
+	case PhaseRelearning:
+		return s.step(now, state, next, grade, s.cfg.RelearningSteps)
+	default:
+		next.Phase = PhaseLearning
+		return s.step(now, state, next, grade, s.cfg.LearningSteps)
+	}
+}
+
+func (s stepScheduler) step(now time.Time, previous, next State, grade int, steps []time.Duration) Result {
+	switch {
+	case len(steps) == 0 || grade == 5:
+		return s.graduate(now, previous, next, grade == 5)
+	case grade < 3:
+		next.Step = 0
+	case grade == 3:
+		// Hard repeats current step; on the first step it waits between the first two
+		if next.Step == 0 && len(steps) > 1 {
+			return Result{State: next, NextReviewTime: now.Add((steps[0] + steps[1]) / 2)}
+		}
+	default:
+		next.Step++
+	}
+
+	if next.Step >= len(steps) {
+		return s.graduate(now, previous, next, false)
+	}
+	return Result{State: next, NextReviewTime: now.Add(steps[next.Step])}
+}
+
+func (s stepScheduler) graduate(now time.Time, previous, next State, easy bool) Result {
+	next = s.memory.graduate(previous, next, easy)
+	next.Interval = s.capInterval(next.Interval)
+	next.Phase = PhaseReview
+	next.Step = 0
+	return Result{State: next, NextReviewTime: now.AddDate(0, 0, next.Interval)}
+}
+
+func (s stepScheduler) capInterval(interval int) int {
+	if s.cfg.MaxInterval > 0 && interval > s.cfg.MaxInterval {
+		interval = s.cfg.MaxInterval
+	}
+	if interval < 1 {
+		interval = 1
+	}
+	return interval
+}
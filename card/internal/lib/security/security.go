@@ -65,6 +65,22 @@ type CustomClaims struct {
 	jwt.RegisteredClaims           // includes exp, nbf, iat, etc.
 }
 
+// ServiceEmail identifies tokens the card service issues for itself
+const ServiceEmail = "card-service"
+
+// IssueToken signs a short-lived token on behalf of the user, so background
+// jobs can call other services without the user's own token
+func (s *Security) IssueToken(userId uuid.UUID) (string, error) {
+	token := jwt.New(jwt.SigningMethodHS256)
+
+	claims := token.Claims.(jwt.MapClaims)
+	claims["uid"] = userId
+	claims["email"] = ServiceEmail
+	claims["exp"] = time.Now().Add(5 * time.Minute).Unix()
+
+	return token.SignedString([]byte(s.PrivateKey))
+}
+
 func (s *Security) validateToken(tokenString string) (jwt.MapClaims, error) {
 	fmt.Println(s.PrivateKey)
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
@@ -133,46 +149,76 @@ func (s *Security) IsAdminMiddleware(client grpcsso.Client) gin.HandlerFunc {
 
 func (s *Security) AuthUnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
-		}
-		authHeaders := md["authorization"]
-		if len(authHeaders) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "authorization token is not supplied")
-		}
-		token := strings.TrimPrefix(authHeaders[0], "Bearer ")
-		jwtClaimsMap, err := s.validateToken(token)
-		fmt.Println("UID:", jwtClaimsMap)
+		ctx, err := s.authenticate(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
-		}
-
-		uid, ok := jwtClaimsMap["uid"].(string)
-		if !ok || uid == "" {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid user ID in token")
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		uidUUID, err := uuid.Parse(uid)
+// AuthStreamInterceptor authenticates streaming calls the same way as unary ones
+func (s *Security) AuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s.authenticate(ss.Context())
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed during parsing user ID: %v", err)
+			return err
 		}
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		email, ok := jwtClaimsMap["email"].(string)
-		if !ok || email == "" {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid email in token")
-		}
+// authServerStream carries the authenticated user in the context of the stream
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		authUser := AuthUser{
-			uidUUID,
-			email,
-			false,
-		}
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
 
-		ctx = context.WithValue(ctx, UserContextKey, authUser)
+// authenticate validates the token from metadata and puts the user into the context
+func (s *Security) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+	authHeaders := md["authorization"]
+	if len(authHeaders) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not supplied")
+	}
+	token := strings.TrimPrefix(authHeaders[0], "Bearer ")
+	jwtClaimsMap, err := s.validateToken(token)
+	fmt.Println("UID:", jwtClaimsMap)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
 
-		fmt.Println("UID:", ctx.Value(UserContextKey))
+	uid, ok := jwtClaimsMap["uid"].(string)
+	if !ok || uid == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid user ID in token")
+	}
 
-		return handler(ctx, req)
+	uidUUID, err := uuid.Parse(uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed during parsing user ID: %v", err)
 	}
-}
\ No newline at end of file
+
+	email, ok := jwtClaimsMap["email"].(string)
+	if !ok || email == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid email in token")
+	}
+
+	authUser := AuthUser{
+		uidUUID,
+		email,
+		false,
+	}
+
+	ctx = context.WithValue(ctx, UserContextKey, authUser)
+
+	fmt.Println("UID:", ctx.Value(UserContextKey))
+
+	return ctx, nil
+}
@@ -1,21 +1,20 @@
 package sm2
 
 import (
-	"fmt"
 	"math"
 	"time"
 )
 
 type ReviewResult struct {
 	NextReviewTime time.Time
-	Interval       int // in minutes
+	Interval       int // in days
 	Easiness       float64
 	Repetitions    int
 }
 
 func SM2(
 	now time.Time,
-	previousInterval int, // in minutes
+	previousInterval int, // in days
 	previousEasiness float64,
 	repetitions int,
 	grade int, // 0–5 scale
@@ -24,16 +23,16 @@ func SM2(
 	easiness := previousEasiness
 	if grade < 3 {
 		repetitions = 0
-		interval = 1 // reset to 5 minutes
+		interval = 1
 	} else {
 		switch repetitions {
 		case 0:
-			interval = 5
+			interval = 1
 		case 1:
-			interval = 30
+			interval = 6
 		default:
-			minutes := float64(previousInterval) * easiness
-			interval = int(math.Round(minutes))
+			days := float64(previousInterval) * easiness
+			interval = int(math.Round(days))
 		}
 		repetitions++
 	}
@@ -43,9 +42,7 @@ func SM2(
 		easiness = 1.3
 	}
 
-	fmt.Println(time.Duration(interval))
-
-	nextReviewTime := now.Add(time.Duration(interval) * time.Minute)
+	nextReviewTime := now.AddDate(0, 0, interval)
 
 	return ReviewResult{
 		NextReviewTime: nextReviewTime,
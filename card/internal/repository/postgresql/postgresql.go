@@ -6,13 +6,17 @@ import (
 
 	// "github.com/tomatoCoderq/card/pkg/model"
 	"github.com/GOeda-Co/proto-contract/model/card"
+	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	// "github.com/tomatoCoderq/card/pkg/scheme"
 	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/tomatoCoderq/card/internal/services/card"
 	"github.com/tomatoCoderq/card/migrations"
 
 	"github.com/google/uuid"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func updateCardFields(cardInitial *model.Card, card *schemes.UpdateCardScheme) {
@@ -37,7 +41,9 @@ func updateCardFields(cardInitial *model.Card, card *schemes.UpdateCardScheme) {
 	if card.RepetitionNumber != 0 {
 		cardInitial.RepetitionNumber = card.RepetitionNumber
 	}
-	// TODO: Add tags here
+	if card.Tags != nil {
+		cardInitial.Tags = card.Tags
+	}
 }
 
 type Repository struct {
@@ -52,9 +58,12 @@ func New(connectionString string, log *slog.Logger) *Repository {
 		return nil
 	}
 
-	migrations.MigrateToLatest(db, log)
+	if err := migrations.MigrateToLatest(db, log); err != nil {
+		log.Error("Error during migration", "error", err)
+		return nil
+	}
 
-	if err := db.AutoMigrate(&model.Card{}); err != nil {
+	if err := db.AutoMigrate(&model.Card{}, &model.Preference{}, &model.DailyProgress{}, &model.ReviewLog{}, &model.OutboxEvent{}, &model.ProcessedAnswer{}, &model.CardRevision{}, &model.DeckChange{}); err != nil {
 		log.Error("Error during auto migration", "error", err)
 		return nil
 	}
@@ -66,22 +75,50 @@ func (cr Repository) AddCard(card *model.Card) error {
 	return cr.db.Create(card).Error
 }
 
-func (cr Repository) ReadAllOwnCardsToLearn(userId uuid.UUID) ([]model.Card, error) {
+// withTag keeps only cards having the tag, all cards if it is empty
+func withTag(tag string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if tag == "" {
+			return db
+		}
+		return db.Where("tags @> ARRAY[?]::text[]", tag)
+	}
+}
+
+// subtreeIds selects ids of the deck and all of its subdecks outside trash.
+// UNION drops decks already visited, so the walk ends even on a cycle
+const subtreeIds = `WITH RECURSIVE subtree AS (
+	SELECT deck_id FROM decks WHERE deck_id = ? AND deleted_at IS NULL
+	UNION
+	SELECT decks.deck_id FROM decks JOIN subtree ON decks.parent_deck_id = subtree.deck_id
+	WHERE decks.deleted_at IS NULL
+) SELECT deck_id FROM subtree`
+
+// ReadAllOwnCardsToLearn reads due cards of the user that are not buried, only
+// of one deck and its subdecks if deckId is set and only with the tag if it is set
+func (cr Repository) ReadAllOwnCardsToLearn(userId uuid.UUID, deckId uuid.UUID, tag string) ([]model.Card, error) {
 	var cards []model.Card
-	err := cr.db.
-		Where("expires_at < ?", time.Now()).
+	now := time.Now()
+	query := cr.db.
+		Where("expires_at < ?", now).
+		Where("buried_until IS NULL OR buried_until <= ?", now).
 		Where("created_by = ?", userId).
-		Find(&cards).Error
+		Scopes(withTag(tag))
+	if deckId != uuid.Nil {
+		query = query.Where("deck_id IN (?)", cr.db.Raw(subtreeIds, deckId))
+	}
+	err := query.Order("expires_at").Find(&cards).Error
 	if err != nil {
 		return nil, err
 	}
 	return cards, err
 }
 
-func (cr Repository) ReadAllOwnCards(userId uuid.UUID) ([]model.Card, error) {
+func (cr Repository) ReadAllOwnCards(userId uuid.UUID, tag string, page pagination.Page) ([]model.Card, error) {
 	var cards []model.Card
 	err := cr.db.
 		Where("created_by = ?", userId).
+		Scopes(withTag(tag), page.Scope("created_at", "card_id")).
 		Find(&cards).
 		Error
 	if err != nil {
@@ -90,10 +127,35 @@ func (cr Repository) ReadAllOwnCards(userId uuid.UUID) ([]model.Card, error) {
 	return cards, err
 }
 
-func (cr Repository) SearchAllPublicCards() ([]model.Card, error) {
+// Full-text query matches stemmed english words as well as words of any
+// language as is, the same way cards_search_vector indexes them
+const (
+	searchQuery = "(SELECT websearch_to_tsquery('english', ?) || websearch_to_tsquery('simple', ?) AS query) AS search"
+	searchRank  = "ts_rank(cards.search_vector, search.query)"
+)
+
+// searchScope keeps cards matching the query, ranks and highlights them.
+// An empty query lists cards newest first
+func searchScope(query string, page pagination.Page) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if query == "" {
+			return db.Scopes(page.Scope("created_at", "card_id"))
+		}
+		return db.
+			Joins("CROSS JOIN "+searchQuery, query, query).
+			Select("cards.*, " + searchRank + " AS rank, " +
+				"ts_headline('english', cards.word, search.query) AS word_highlight, " +
+				"ts_headline('english', cards.translation, search.query) AS translation_highlight").
+			Where("cards.search_vector @@ search.query").
+			Scopes(page.RankedScope(searchRank, "cards.created_at", "cards.card_id"))
+	}
+}
+
+func (cr Repository) SearchAllPublicCards(query string, page pagination.Page) ([]model.Card, error) {
 	var cards []model.Card
 	err := cr.db.
 		Where("is_public = ?", true).
+		Scopes(searchScope(query, page)).
 		Find(&cards).Error
 	if err != nil {
 		return nil, err
@@ -101,11 +163,12 @@ func (cr Repository) SearchAllPublicCards() ([]model.Card, error) {
 	return cards, nil
 }
 
-func (cr Repository) SearchUserPublicCards(userId uuid.UUID) ([]model.Card, error) {
+func (cr Repository) SearchUserPublicCards(userId uuid.UUID, query string, page pagination.Page) ([]model.Card, error) {
 	var cards []model.Card
 	err := cr.db.
 		Where("is_public = ?", true).
 		Where("created_by = ?", userId).
+		Scopes(searchScope(query, page)).
 		Find(&cards).Error
 	if err != nil {
 		return nil, err
@@ -124,11 +187,410 @@ func (cr Repository) UpdateCard(card *model.Card, cardUpdate *schemes.UpdateCard
 	return card, cr.db.Updates(card).Error
 }
 
+// PureUpdate writes every column of the card, so zero values produced by
+// the scheduler (e.g. repetitions reset after a lapse) are persisted too.
+// updated_at is written as given rather than bumped: it is the time content was
+// last edited, which reviews must not move or offline answers look outdated
 func (cr Repository) PureUpdate(card *model.Card) error {
-	return cr.db.Updates(card).Error
+	return cr.db.Select("*").Omit("User").UpdateColumns(card).Error
 }
 
 func (cr Repository) DeleteCard(cardId uuid.UUID) error {
 	err := cr.db.Delete(&model.Card{}, "card_id = ?", cardId).Error
 	return err
 }
+
+// ReadCards reads cards by IDs, missing ones are skipped
+func (cr Repository) ReadCards(cardIds []uuid.UUID) ([]model.Card, error) {
+	var cards []model.Card
+	err := cr.db.Where("card_id IN ?", cardIds).Find(&cards).Error
+	return cards, err
+}
+
+// ReadSiblings reads other cards of the note
+func (cr Repository) ReadSiblings(noteId uuid.UUID, cardId uuid.UUID) ([]model.Card, error) {
+	var cards []model.Card
+	err := cr.db.Where("note_id = ? AND card_id <> ?", noteId, cardId).Find(&cards).Error
+	return cards, err
+}
+
+// BurySiblings leaves other cards of the note out of the study queue until the
+// given time, nil unburies them
+func (cr Repository) BurySiblings(noteId uuid.UUID, cardId uuid.UUID, until *time.Time) error {
+	return cr.db.Model(&model.Card{}).
+		Where("note_id = ? AND card_id <> ?", noteId, cardId).
+		UpdateColumn("buried_until", until).Error
+}
+
+func (cr Repository) ReadCardsWithTag(userId uuid.UUID, tag string) ([]model.Card, error) {
+	var cards []model.Card
+	err := cr.db.
+		Where("created_by = ?", userId).
+		Scopes(withTag(tag)).
+		Find(&cards).Error
+	return cards, err
+}
+
+// ReadTagCounts counts cards of the user per tag, most used tags first
+func (cr Repository) ReadTagCounts(userId uuid.UUID) ([]model.TagCount, error) {
+	var tags []model.TagCount
+	err := cr.db.
+		Model(&model.Card{}).
+		Joins("CROSS JOIN unnest(cards.tags) AS tag").
+		Select("tag, count(*) AS count").
+		Where("created_by = ?", userId).
+		Group("tag").
+		Order("count DESC, tag").
+		Scan(&tags).Error
+	return tags, err
+}
+
+func (cr Repository) ReadTrashedCards(userId uuid.UUID) ([]model.Card, error) {
+	var cards []model.Card
+	err := cr.db.Unscoped().
+		Where("created_by = ? AND deleted_at IS NOT NULL", userId).
+		Order("deleted_at DESC").
+		Find(&cards).Error
+	return cards, err
+}
+
+func (cr Repository) ReadTrashedCard(cardId uuid.UUID) (*model.Card, error) {
+	var card model.Card
+	err := cr.db.Unscoped().
+		Where("card_id = ? AND deleted_at IS NOT NULL", cardId).
+		Find(&card).Error
+	return &card, err
+}
+
+func (cr Repository) RestoreCard(cardId uuid.UUID) error {
+	return cr.db.Unscoped().
+		Model(&model.Card{}).
+		Where("card_id = ?", cardId).
+		Update("deleted_at", nil).Error
+}
+
+// IsDeckTrashed tells whether the deck is in trash. Decks live in the same database
+func (cr Repository) IsDeckTrashed(deckId uuid.UUID) (bool, error) {
+	var count int64
+	err := cr.db.Unscoped().
+		Model(&modelDeck.Deck{}).
+		Where("deck_id = ? AND deleted_at IS NOT NULL", deckId).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ReadDeck returns a deck out of trash, empty if there is none
+func (cr Repository) ReadDeck(deckId uuid.UUID) (*modelDeck.Deck, error) {
+	var deck modelDeck.Deck
+	err := cr.db.Where("deck_id = ?", deckId).Find(&deck).Error
+	return &deck, err
+}
+
+func (cr Repository) TrashDeckCards(deckId uuid.UUID, deletedAt time.Time) (int64, error) {
+	result := cr.db.Model(&model.Card{}).
+		Where("deck_id = ?", deckId).
+		Update("deleted_at", deletedAt)
+	return result.RowsAffected, result.Error
+}
+
+// MoveDeckCards moves cards to the target deck. As when a card is added to a
+// deck, cards take visibility of the deck and the deck counts them
+func (cr Repository) MoveDeckCards(deckId uuid.UUID, target *modelDeck.Deck) (int64, error) {
+	var moved int64
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Card{}).
+			Where("deck_id = ?", deckId).
+			Updates(map[string]any{"deck_id": target.DeckId, "is_public": target.IsPublic})
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+
+		return tx.Model(&modelDeck.Deck{}).
+			Where("deck_id = ?", target.DeckId).
+			UpdateColumn("cards_quantity", gorm.Expr("cards_quantity + ?", moved)).Error
+	})
+	return moved, err
+}
+
+// ReadDeckCards reads content of cards in the deck, without scheduling state
+func (cr Repository) ReadDeckCards(deckId uuid.UUID) ([]model.Card, error) {
+	var cards []model.Card
+	err := cr.db.Select("card_id", "word", "translation", "tags", "created_at", "template", "note_id", "ordinal").
+		Where("deck_id = ?", deckId).
+		Find(&cards).Error
+	return cards, err
+}
+
+// AddDeckCards inserts cards of the deck in batches and adds them to the count of the deck
+func (cr Repository) AddDeckCards(deckId uuid.UUID, cards []model.Card) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(cards, 100).Error; err != nil {
+			return err
+		}
+		return tx.Model(&modelDeck.Deck{}).
+			Where("deck_id = ?", deckId).
+			UpdateColumn("cards_quantity", gorm.Expr("cards_quantity + ?", len(cards))).Error
+	})
+}
+
+// RemoveDeckCards moves cards of the deck to trash and takes them off the count of the deck
+func (cr Repository) RemoveDeckCards(deckId uuid.UUID, cardIds []uuid.UUID) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("deck_id = ? AND card_id IN ?", deckId, cardIds).Delete(&model.Card{})
+		if result.Error != nil {
+			return result.Error
+		}
+		return tx.Model(&modelDeck.Deck{}).
+			Where("deck_id = ?", deckId).
+			UpdateColumn("cards_quantity", gorm.Expr("GREATEST(cards_quantity - ?, 0)", result.RowsAffected)).Error
+	})
+}
+
+// ReadClonedCards reads cards of the deck cloned from other cards, trashed ones included
+func (cr Repository) ReadClonedCards(deckId uuid.UUID) ([]model.Card, error) {
+	var cards []model.Card
+	err := cr.db.Unscoped().
+		Where("deck_id = ? AND source_card_id IS NOT NULL", deckId).
+		Find(&cards).Error
+	return cards, err
+}
+
+func (cr Repository) SetKeepLocal(cardId uuid.UUID, keepLocal bool) error {
+	return cr.db.Model(&model.Card{}).
+		Where("card_id = ?", cardId).
+		Update("keep_local", keepLocal).Error
+}
+
+// ReadPublishedCards reads cards of the deck as of its last version: the last
+// change of every card still in the deck
+func (cr Repository) ReadPublishedCards(deckId uuid.UUID) ([]model.DeckChange, error) {
+	latest := cr.db.Model(&model.DeckChange{}).
+		Select("DISTINCT ON (card_id) *").
+		Where("deck_id = ?", deckId).
+		Order("card_id, version DESC")
+
+	var changes []model.DeckChange
+	err := cr.db.Table("(?) AS latest", latest).
+		Where("action <> ?", model.DeckChangeDelete).
+		Find(&changes).Error
+	return changes, err
+}
+
+// PublishDeckChanges records changes of the deck as the version
+func (cr Repository) PublishDeckChanges(deckId uuid.UUID, version int, changes []model.DeckChange) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(changes, 100).Error; err != nil {
+			return err
+		}
+		return tx.Model(&modelDeck.Deck{}).
+			Where("deck_id = ?", deckId).
+			UpdateColumn("version", version).Error
+	})
+}
+
+// ReadDeckChanges reads changes of the deck published after the version, oldest first
+func (cr Repository) ReadDeckChanges(deckId uuid.UUID, sinceVersion int) ([]model.DeckChange, error) {
+	var changes []model.DeckChange
+	err := cr.db.
+		Where("deck_id = ? AND version > ?", deckId, sinceVersion).
+		Order("version, word").
+		Find(&changes).Error
+	return changes, err
+}
+
+func (cr Repository) SetSyncedVersion(deckId uuid.UUID, version int) error {
+	return cr.db.Model(&modelDeck.Deck{}).
+		Where("deck_id = ?", deckId).
+		UpdateColumn("synced_version", version).Error
+}
+
+// DetachDeckCards takes cards out of the deck, cards without a deck are private
+func (cr Repository) DetachDeckCards(deckId uuid.UUID) (int64, error) {
+	result := cr.db.Model(&model.Card{}).
+		Where("deck_id = ?", deckId).
+		Updates(map[string]any{"deck_id": uuid.Nil, "is_public": false})
+	return result.RowsAffected, result.Error
+}
+
+// PurgeCards permanently deletes cards trashed before the given time together
+// with their history, review logs and processed answers
+func (cr Repository) PurgeCards(before time.Time) (int64, error) {
+	var purged int64
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		trashed := tx.Unscoped().
+			Model(&model.Card{}).
+			Select("card_id").
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before)
+
+		for _, related := range []any{&model.CardRevision{}, &model.ReviewLog{}, &model.ProcessedAnswer{}} {
+			if err := tx.Where("card_id IN (?)", trashed).Delete(related).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Unscoped().
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Delete(&model.Card{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	return purged, err
+}
+
+func (cr Repository) ReadPreference(userId uuid.UUID) (*model.Preference, error) {
+	var preference model.Preference
+	err := cr.db.Where("user_id = ?", userId).Find(&preference).Error
+	return &preference, err
+}
+
+func (cr Repository) UpsertPreference(preference *model.Preference) error {
+	return cr.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"algorithm", "updated_at"}),
+	}).Create(preference).Error
+}
+
+// ReadDeckOptions reads study settings owned by deck service from the shared database
+func (cr Repository) ReadDeckOptions(deckId uuid.UUID) (*modelDeck.Options, error) {
+	var options modelDeck.Options
+	err := cr.db.Where("deck_id = ?", deckId).Find(&options).Error
+	return &options, err
+}
+
+// ReadMemberRole reads the role of the user in a deck shared by deck service, empty when the user is not a member
+func (cr Repository) ReadMemberRole(deckId uuid.UUID, userId uuid.UUID) (string, error) {
+	var member modelDeck.Member
+	err := cr.db.Where("deck_id = ? AND user_id = ?", deckId, userId).Find(&member).Error
+	return member.Role, err
+}
+
+func (cr Repository) ReadDailyProgress(userId uuid.UUID, day time.Time) ([]model.DailyProgress, error) {
+	var progress []model.DailyProgress
+	err := cr.db.Where("user_id = ? AND day = ?", userId, day).Find(&progress).Error
+	return progress, err
+}
+
+// AddDailyProgress adds counters of progress to the ones already stored for the day
+func (cr Repository) AddDailyProgress(progress *model.DailyProgress) error {
+	return cr.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "deck_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"new_introduced": gorm.Expr("daily_progress.new_introduced + EXCLUDED.new_introduced"),
+			"reviews_done":   gorm.Expr("daily_progress.reviews_done + EXCLUDED.reviews_done"),
+		}),
+	}).Create(progress).Error
+}
+
+func (cr Repository) AddReviewLog(reviewLog *model.ReviewLog) error {
+	return cr.db.Create(reviewLog).Error
+}
+
+// ReadLastReviewLog reads the last answer of the user. Imported history has no
+// review in stats and can't be undone, so it is skipped
+func (cr Repository) ReadLastReviewLog(userId uuid.UUID) (*model.ReviewLog, error) {
+	var reviewLog model.ReviewLog
+	err := cr.db.
+		Where("user_id = ? AND review_id <> ''", userId).
+		Order("reviewed_at DESC").
+		Limit(1).
+		Find(&reviewLog).Error
+	return &reviewLog, err
+}
+
+func (cr Repository) AddReviewLogs(reviewLogs []model.ReviewLog) error {
+	return cr.db.CreateInBatches(reviewLogs, 100).Error
+}
+
+// ReadDeckReviewLogs reads answers of the user to cards now in the deck, oldest first
+func (cr Repository) ReadDeckReviewLogs(deckId uuid.UUID, userId uuid.UUID) ([]model.ReviewLog, error) {
+	var reviewLogs []model.ReviewLog
+	err := cr.db.
+		Where("user_id = ?", userId).
+		Where("card_id IN (?)", cr.db.Model(&model.Card{}).Select("card_id").Where("deck_id = ?", deckId)).
+		Order("reviewed_at").
+		Find(&reviewLogs).Error
+	return reviewLogs, err
+}
+
+func (cr Repository) DeleteReviewLog(reviewLogId uuid.UUID) error {
+	return cr.db.Delete(&model.ReviewLog{}, "review_log_id = ?", reviewLogId).Error
+}
+
+func (cr Repository) Transaction(fn func(repo services.CardRepository) error) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		return fn(Repository{db: tx})
+	})
+}
+
+func (cr Repository) AddOutboxEvent(event *model.OutboxEvent) error {
+	return cr.db.Create(event).Error
+}
+
+func (cr Repository) ReadProcessedAnswer(userId uuid.UUID, requestId string) (*model.ProcessedAnswer, error) {
+	var processed model.ProcessedAnswer
+	err := cr.db.
+		Where("user_id = ? AND request_id = ?", userId, requestId).
+		Limit(1).
+		Find(&processed).Error
+	return &processed, err
+}
+
+func (cr Repository) AddCardRevision(revision *model.CardRevision) error {
+	return cr.db.Create(revision).Error
+}
+
+func (cr Repository) ReadCardRevisions(cardId uuid.UUID) ([]model.CardRevision, error) {
+	var revisions []model.CardRevision
+	err := cr.db.Where("card_id = ?", cardId).Order("revision").Find(&revisions).Error
+	return revisions, err
+}
+
+func (cr Repository) ReadCardRevision(cardId uuid.UUID, revision int) (*model.CardRevision, error) {
+	var cardRevision model.CardRevision
+	err := cr.db.
+		Where("card_id = ? AND revision = ?", cardId, revision).
+		Limit(1).
+		Find(&cardRevision).Error
+	return &cardRevision, err
+}
+
+// AddProcessedAnswer stores the result of an answer, a result already stored for
+// the request ID is kept and services.ErrAnswerProcessed is returned
+func (cr Repository) AddProcessedAnswer(processed *model.ProcessedAnswer) error {
+	result := cr.db.Clauses(clause.OnConflict{DoNothing: true}).Create(processed)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return services.ErrAnswerProcessed
+	}
+	return nil
+}
+
+// ReadPendingOutboxEvents reads undelivered events that still have attempts left
+// and are due at now, oldest first. Events of a user are left out while an
+// earlier event of the user waits for its retry, so they never overtake it
+func (cr Repository) ReadPendingOutboxEvents(now time.Time, maxAttempts, limit int) ([]model.OutboxEvent, error) {
+	var events []model.OutboxEvent
+	err := cr.db.
+		Where("delivered_at IS NULL").
+		Where("attempts < ?", maxAttempts).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox AS earlier
+			WHERE earlier.user_id = outbox.user_id
+				AND earlier.delivered_at IS NULL
+				AND earlier.attempts < ?
+				AND earlier.occurred_at < outbox.occurred_at
+				AND earlier.next_attempt_at > ?
+		)`, maxAttempts, now).
+		Order("occurred_at").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (cr Repository) UpdateOutboxEvent(event *model.OutboxEvent) error {
+	return cr.db.Select("attempts", "next_attempt_at", "delivered_at", "last_error").Updates(event).Error
+}
@@ -2,193 +2,2104 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"math/rand"
 	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
 
-	"github.com/tomatoCoderq/card/internal/lib/sm2"
+	"github.com/tomatoCoderq/card/internal/lib/scheduler"
 
 	"github.com/GOeda-Co/proto-contract/model/card"
+	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 )
 
 type CardRepository interface {
 	AddCard(card *model.Card) error
-	ReadAllOwnCardsToLearn(userId uuid.UUID) ([]model.Card, error)
-	ReadAllOwnCards(userId uuid.UUID) ([]model.Card, error)
-	SearchAllPublicCards() ([]model.Card, error)
-	SearchUserPublicCards(userId uuid.UUID) ([]model.Card, error)
+	ReadAllOwnCardsToLearn(userId uuid.UUID, deckId uuid.UUID, tag string) ([]model.Card, error)
+	ReadAllOwnCards(userId uuid.UUID, tag string, page pagination.Page) ([]model.Card, error)
+	SearchAllPublicCards(query string, page pagination.Page) ([]model.Card, error)
+	SearchUserPublicCards(userId uuid.UUID, query string, page pagination.Page) ([]model.Card, error)
 	ReadCard(cardId uuid.UUID) (*model.Card, error)
 	PureUpdate(card *model.Card) error
 	UpdateCard(card *model.Card, cardUpdate *schemes.UpdateCardScheme) (*model.Card, error)
 	DeleteCard(cardId uuid.UUID) error
+	ReadPreference(userId uuid.UUID) (*model.Preference, error)
+	UpsertPreference(preference *model.Preference) error
+	ReadDeckOptions(deckId uuid.UUID) (*modelDeck.Options, error)
+	ReadDailyProgress(userId uuid.UUID, day time.Time) ([]model.DailyProgress, error)
+	AddDailyProgress(progress *model.DailyProgress) error
+	AddReviewLog(reviewLog *model.ReviewLog) error
+	ReadLastReviewLog(userId uuid.UUID) (*model.ReviewLog, error)
+	DeleteReviewLog(reviewLogId uuid.UUID) error
+	AddOutboxEvent(event *model.OutboxEvent) error
+	ReadProcessedAnswer(userId uuid.UUID, requestId string) (*model.ProcessedAnswer, error)
+	AddProcessedAnswer(processed *model.ProcessedAnswer) error
+	AddCardRevision(revision *model.CardRevision) error
+	ReadTrashedCards(userId uuid.UUID) ([]model.Card, error)
+	ReadTrashedCard(cardId uuid.UUID) (*model.Card, error)
+	RestoreCard(cardId uuid.UUID) error
+	IsDeckTrashed(deckId uuid.UUID) (bool, error)
+	ReadDeck(deckId uuid.UUID) (*modelDeck.Deck, error)
+	ReadMemberRole(deckId uuid.UUID, userId uuid.UUID) (string, error)
+	TrashDeckCards(deckId uuid.UUID, deletedAt time.Time) (int64, error)
+	MoveDeckCards(deckId uuid.UUID, target *modelDeck.Deck) (int64, error)
+	DetachDeckCards(deckId uuid.UUID) (int64, error)
+	ReadCardRevisions(cardId uuid.UUID) ([]model.CardRevision, error)
+	ReadCardRevision(cardId uuid.UUID, revision int) (*model.CardRevision, error)
+	ReadCards(cardIds []uuid.UUID) ([]model.Card, error)
+	ReadCardsWithTag(userId uuid.UUID, tag string) ([]model.Card, error)
+	ReadTagCounts(userId uuid.UUID) ([]model.TagCount, error)
+	ReadDeckCards(deckId uuid.UUID) ([]model.Card, error)
+	AddDeckCards(deckId uuid.UUID, cards []model.Card) error
+	AddReviewLogs(reviewLogs []model.ReviewLog) error
+	ReadDeckReviewLogs(deckId uuid.UUID, userId uuid.UUID) ([]model.ReviewLog, error)
+	RemoveDeckCards(deckId uuid.UUID, cardIds []uuid.UUID) error
+	ReadClonedCards(deckId uuid.UUID) ([]model.Card, error)
+	SetKeepLocal(cardId uuid.UUID, keepLocal bool) error
+	ReadPublishedCards(deckId uuid.UUID) ([]model.DeckChange, error)
+	PublishDeckChanges(deckId uuid.UUID, version int, changes []model.DeckChange) error
+	ReadDeckChanges(deckId uuid.UUID, sinceVersion int) ([]model.DeckChange, error)
+	SetSyncedVersion(deckId uuid.UUID, version int) error
+	ReadSiblings(noteId uuid.UUID, cardId uuid.UUID) ([]model.Card, error)
+	BurySiblings(noteId uuid.UUID, cardId uuid.UUID, until *time.Time) error
+	// Transaction runs fn with repository bound to a single database transaction
+	Transaction(fn func(repo CardRepository) error) error
 }
 
-type StatsClient interface {
-	AddRecord(ctx context.Context, deckId, cardId string, grade int) (string, error)
-}
+var (
+	ErrNothingToUndo     = errors.New("no answer to undo")
+	ErrInvalidAnswerTime = errors.New("answer time is missing or in the future")
+	ErrCardNotFound      = errors.New("card not found")
+	ErrNotCardOwner      = errors.New("card belongs to another user")
+	ErrRevisionNotFound  = errors.New("revision not found")
+	ErrDeckInTrash       = errors.New("deck of the card is in trash, restore the deck first")
+	ErrDeckNotFound      = errors.New("deck not found")
+	ErrNotDeckOwner      = errors.New("your role in the deck does not allow this")
+	ErrInvalidDeleteMode = errors.New("delete mode must be delete, move or detach")
+	ErrInvalidTargetDeck = errors.New("cards can be moved only to another deck the user can edit")
+	ErrInvalidTag        = fmt.Errorf("tags must be 1-%d characters long without spaces", MaxTagLength)
+	ErrNoCardsSelected   = errors.New("no cards selected")
+	ErrTooManyRows       = fmt.Errorf("import is limited to %d rows", MaxImportRows)
+	ErrNotSubscribed     = errors.New("deck is not subscribed to a shared deck")
+	ErrNotClonedCard     = errors.New("card is not cloned from another card")
+	ErrInvalidTemplate   = errors.New("template must be forward, reverse, both or cloze")
+	ErrInvalidCloze      = errors.New("cloze cards need at least one cloze deletion like {{c1::answer}}")
+	ErrAnswerProcessed   = errors.New("answer with the request ID is already processed")
+
+	// Reported per row of an import
+	ErrWordRequired        = errors.New("word is required")
+	ErrTranslationRequired = errors.New("translation is required")
+	ErrFieldTooLong        = fmt.Errorf("word and translation are limited to %d characters", MaxFieldLength)
+	ErrDuplicateCard       = errors.New("card with the same word and translation is already in the deck")
+	ErrInvalidSchedule     = errors.New("schedule phase must be learning, review or relearning")
+	ErrInvalidReview       = errors.New("reviews need a grade from 0 to 5 and a time in the past")
+)
+
+// MaxTagLength limits length of a tag in characters
+const MaxTagLength = 50
+
+// MaxFieldLength limits length of word and translation in characters
+const MaxFieldLength = 100
+
+// MaxImportRows limits rows of a single import
+const MaxImportRows = 5000
+
+// minImportEasiness is the lowest easiness SM-2 lets a card reach
+const minImportEasiness = 1.3
+
+// Reasons an offline answer could not be replayed as given
+const (
+	ConflictDeleted    = "deleted"
+	ConflictSuperseded = "superseded"
+	ConflictEdited     = "edited"
+)
+
+// clockSkew tolerates client clocks running slightly ahead of the server
+const clockSkew = time.Minute
 
 type Card struct {
 	log            *slog.Logger
 	cardRepository CardRepository
-	statClient     StatsClient
 }
 
 func New(
 	log *slog.Logger,
 	cardRepo CardRepository,
-	statClient StatsClient,
 ) *Card {
 	return &Card{
 		log:            log,
 		cardRepository: cardRepo,
-		statClient:     statClient,
 	}
 }
 
+// AddCard adds the card. With TemplateBoth it adds a forward card and its
+// reverse sibling, a cloze card is added once for every cloze index of its word.
+// The first card is returned with the others in Siblings
 func (cs Card) AddCard(card *model.Card) (*model.Card, error) {
-	err := cs.cardRepository.AddCard(card)
+	tags, err := normalizeTags(card.Tags)
+	if err != nil {
+		return nil, err
+	}
+	card.Tags = tags
+
+	siblings, err := splitNote(card)
+	if err != nil {
+		return nil, err
+	}
+	if len(siblings) == 0 {
+		if err := cs.cardRepository.AddCard(card); err != nil {
+			return nil, err
+		}
+		return card, nil
+	}
+
+	err = cs.cardRepository.Transaction(func(repo CardRepository) error {
+		if err := repo.AddCard(card); err != nil {
+			return err
+		}
+		for i := range siblings {
+			if err := repo.AddCard(&siblings[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	card.Siblings = siblings
 	return card, nil
 }
 
+// splitNote turns the card being added into the first card of its note and
+// returns the other cards of the note, each reviewed on its own
+func splitNote(card *model.Card) ([]model.Card, error) {
+	if !model.ValidTemplate(card.Template) {
+		return nil, ErrInvalidTemplate
+	}
+
+	var siblings []model.Card
+	switch card.Template {
+	case model.TemplateBoth:
+		noteId := uuid.New()
+		card.Template = model.TemplateForward
+		card.NoteId = &noteId
+		reverse := *card
+		reverse.CardId = uuid.Nil
+		reverse.Template = model.TemplateReverse
+		siblings = append(siblings, reverse)
+	case model.TemplateCloze:
+		indexes := model.ClozeIndexes(card.Word)
+		if len(indexes) == 0 {
+			return nil, ErrInvalidCloze
+		}
+		// A note even for a single index, so indexes added later join it
+		noteId := uuid.New()
+		card.NoteId = &noteId
+		card.Ordinal = indexes[0]
+		for _, index := range indexes[1:] {
+			siblings = append(siblings, clozeCard(card, index))
+		}
+	}
+	return siblings, nil
+}
+
+// clozeCard is a new card of the cloze note asking the index
+func clozeCard(note *model.Card, index int) model.Card {
+	return model.Card{
+		CreatedBy:   note.CreatedBy,
+		Word:        note.Word,
+		Translation: note.Translation,
+		DeckID:      note.DeckID,
+		Tags:        note.Tags,
+		IsPublic:    note.IsPublic,
+		Template:    model.TemplateCloze,
+		NoteId:      note.NoteId,
+		Ordinal:     index,
+	}
+}
+
 func (cm Card) ReadAllOwnCardsToLearn(userId uuid.UUID) ([]model.Card, error) {
-	cards, err := cm.cardRepository.ReadAllOwnCardsToLearn(userId)
+	return cm.ReadStudyQueue(userId, uuid.Nil, "")
+}
+
+// ReadStudyQueue returns today's queue of the user, optionally only for one deck
+// with all of its subdecks and only for cards with a tag.
+// Due learning cards go first, then reviews interleaved with new cards. New and
+// review cards are capped by daily limits of their decks minus what was
+// already studied today. Decks burying siblings get one new or review card of
+// a note a day, reviews taking precedence
+func (cm Card) ReadStudyQueue(userId uuid.UUID, deckId uuid.UUID, tag string) ([]model.Card, error) {
+	tag, err := normalizeFilterTag(tag)
 	if err != nil {
 		return nil, err
 	}
-	return cards, nil
-}
 
-func (cm Card) ReadAllOwnCards(userId uuid.UUID) ([]model.Card, error) {
-	cards, err := cm.cardRepository.ReadAllOwnCards(userId)
+	cards, err := cm.cardRepository.ReadAllOwnCardsToLearn(userId, deckId, tag)
 	if err != nil {
 		return nil, err
 	}
-	return cards, nil
-}
 
-func (cm Card) UpdateCard(cardId uuid.UUID, cardUpdate *schemes.UpdateCardScheme, userId uuid.UUID) (*model.Card, error) {
-	cardFound, err := cm.cardRepository.ReadCard(cardId)
+	progress, err := cm.cardRepository.ReadDailyProgress(userId, studyDay(time.Now()))
 	if err != nil {
 		return nil, err
 	}
 
-	if cardFound.CreatedBy != userId {
-		return nil, fmt.Errorf("cannot update other's user card")
+	studied := make(map[uuid.UUID]model.DailyProgress, len(progress))
+	for _, p := range progress {
+		studied[p.DeckId] = p
+	}
+
+	var deckIds []uuid.UUID
+	byDeck := make(map[uuid.UUID][]model.Card)
+	for _, card := range cards {
+		if _, ok := byDeck[card.DeckID]; !ok {
+			deckIds = append(deckIds, card.DeckID)
+		}
+		byDeck[card.DeckID] = append(byDeck[card.DeckID], card)
+	}
+
+	var learning, reviews, newCards []model.Card
+	notes := make(map[uuid.UUID]bool)
+	for _, deckId := range deckIds {
+		options, err := cm.deckOptions(deckId)
+		if err != nil {
+			return nil, err
+		}
+
+		var deckNew, deckReviews []model.Card
+		for _, card := range byDeck[deckId] {
+			switch scheduler.Phase(card.Phase) {
+			case scheduler.PhaseNew, "":
+				deckNew = append(deckNew, card)
+			case scheduler.PhaseReview:
+				deckReviews = append(deckReviews, card)
+			default:
+				learning = append(learning, card)
+			}
+		}
+
+		if options.BurySiblings {
+			deckReviews = firstOfNotes(deckReviews, notes)
+			deckNew = firstOfNotes(deckNew, notes)
+		}
+
+		if options.NewCardOrder == modelDeck.NewCardOrderRandom {
+			rand.Shuffle(len(deckNew), func(i, j int) { deckNew[i], deckNew[j] = deckNew[j], deckNew[i] })
+		} else {
+			sort.SliceStable(deckNew, func(i, j int) bool { return deckNew[i].CreatedAt.Before(deckNew[j].CreatedAt) })
+		}
+
+		newLeft := max(options.NewPerDay-studied[deckId].NewIntroduced, 0)
+		reviewsLeft := max(options.MaxReviewsPerDay-studied[deckId].ReviewsDone, 0)
+
+		reviews = append(reviews, deckReviews[:min(len(deckReviews), reviewsLeft)]...)
+		newCards = append(newCards, deckNew[:min(len(deckNew), newLeft)]...)
+	}
+
+	sort.SliceStable(learning, func(i, j int) bool { return learning[i].ExpiresAt.Before(learning[j].ExpiresAt) })
+
+	return append(learning, interleave(reviews, newCards)...), nil
+}
+
+// firstOfNotes drops cards of notes already seen and marks notes of kept cards seen
+func firstOfNotes(cards []model.Card, seen map[uuid.UUID]bool) []model.Card {
+	kept := cards[:0]
+	for _, card := range cards {
+		if card.NoteId != nil {
+			if seen[*card.NoteId] {
+				continue
+			}
+			seen[*card.NoteId] = true
+		}
+		kept = append(kept, card)
+	}
+	return kept
+}
+
+// interleave spreads new cards evenly between reviews
+func interleave(reviews, newCards []model.Card) []model.Card {
+	queue := make([]model.Card, 0, len(reviews)+len(newCards))
+	r, n := 0, 0
+	for r < len(reviews) || n < len(newCards) {
+		if n == len(newCards) || (r < len(reviews) && r*len(newCards) < (n+1)*len(reviews)) {
+			queue = append(queue, reviews[r])
+			r++
+		} else {
+			queue = append(queue, newCards[n])
+			n++
+		}
+	}
+	return queue
+}
+
+// studyDay is the UTC day daily limits are counted for
+func studyDay(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ReadAllOwnCards returns a page of the user's cards, only with the tag if it
+// is set, and the cursor of the next page
+func (cm Card) ReadAllOwnCards(userId uuid.UUID, tag string, page pagination.Page) ([]model.Card, string, error) {
+	tag, err := normalizeFilterTag(tag)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cards, err := cm.cardRepository.ReadAllOwnCards(userId, tag, page)
+	if err != nil {
+		return nil, "", err
 	}
+	cards, next := pagination.Trim(cards, page, cardCursor)
+	return cards, next, nil
+}
+
+func cardCursor(card model.Card) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: card.CreatedAt, Id: card.CardId, Rank: card.Rank}
+}
+
+// ReadTrashedCards returns cards of the user in trash, recently deleted first
+func (cm Card) ReadTrashedCards(userId uuid.UUID) ([]model.Card, error) {
+	return cm.cardRepository.ReadTrashedCards(userId)
+}
 
-	cardUpdated, err := cm.cardRepository.UpdateCard(cardFound, cardUpdate)
+// RestoreCard takes the card out of trash. Cards of a trashed deck are
+// restored together with the deck
+func (cm Card) RestoreCard(cardId uuid.UUID, userId uuid.UUID) (*model.Card, error) {
+	card, err := cm.cardRepository.ReadTrashedCard(cardId)
 	if err != nil {
 		return nil, err
 	}
+	if card.CardId == uuid.Nil {
+		return nil, ErrCardNotFound
+	}
+	if err := cm.authorizeCard(card, userId, modelDeck.RoleEditor); err != nil {
+		return nil, err
+	}
 
-	return cardUpdated, nil
+	if card.DeckID != uuid.Nil {
+		trashed, err := cm.cardRepository.IsDeckTrashed(card.DeckID)
+		if err != nil {
+			return nil, err
+		}
+		if trashed {
+			return nil, ErrDeckInTrash
+		}
+	}
+
+	if err := cm.cardRepository.RestoreCard(cardId); err != nil {
+		return nil, err
+	}
+
+	card.DeletedAt = gorm.DeletedAt{}
+	return card, nil
 }
 
-func (cm Card) DeleteCard(cardId uuid.UUID, userId uuid.UUID) error {
-	cardFound, err := cm.cardRepository.ReadCard(cardId)
+// ReleaseDeckCards applies deletion of the deck to its cards. In delete mode they are
+// trashed with the same deletion time as the deck, so they are restored together
+func (cm Card) ReleaseDeckCards(deckId uuid.UUID, userId uuid.UUID, mode string, targetDeckId uuid.UUID, deletedAt time.Time) (int64, error) {
+	deck, err := cm.cardRepository.ReadDeck(deckId)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return 0, ErrDeckNotFound
+	}
+	if err := cm.authorizeDeck(deck, userId, modelDeck.RoleOwner); err != nil {
+		return 0, err
 	}
 
-	if cardFound == nil || reflect.DeepEqual(cardFound, &model.Card{}) {
-		return fmt.Errorf("card not found")
+	switch mode {
+	case modelDeck.DeleteModeCards, "":
+		if deletedAt.IsZero() {
+			deletedAt = time.Now()
+		}
+		return cm.cardRepository.TrashDeckCards(deckId, deletedAt)
+	case modelDeck.DeleteModeMove:
+		if targetDeckId == deckId {
+			return 0, ErrInvalidTargetDeck
+		}
+		target, err := cm.cardRepository.ReadDeck(targetDeckId)
+		if err != nil {
+			return 0, err
+		}
+		if target.DeckId == uuid.Nil {
+			return 0, ErrInvalidTargetDeck
+		}
+		if err := cm.authorizeDeck(target, userId, modelDeck.RoleEditor); err != nil {
+			if errors.Is(err, ErrNotDeckOwner) {
+				return 0, ErrInvalidTargetDeck
+			}
+			return 0, err
+		}
+		return cm.cardRepository.MoveDeckCards(deckId, target)
+	case modelDeck.DeleteModeDetach:
+		return cm.cardRepository.DetachDeckCards(deckId)
+	}
+	return 0, ErrInvalidDeleteMode
+}
+
+// CloneDeckCards copies cards of a public or shared deck into a deck the user edits. Copies
+// keep content and tags, start as new cards and refer back to their source cards
+func (cm Card) CloneDeckCards(sourceDeckId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) (int, error) {
+	source, err := cm.cardRepository.ReadDeck(sourceDeckId)
+	if err != nil {
+		return 0, err
+	}
+	if source.DeckId == uuid.Nil {
+		return 0, ErrDeckNotFound
+	}
+	if !source.IsPublic {
+		if err := cm.authorizeDeck(source, userId, modelDeck.RoleViewer); err != nil {
+			return 0, err
+		}
 	}
 
-	if cardFound.CreatedBy != userId {
-		return fmt.Errorf("cannot delete other's user card")
+	deck, err := cm.cardRepository.ReadDeck(deckId)
+	if err != nil {
+		return 0, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return 0, ErrDeckNotFound
+	}
+	if err := cm.authorizeDeck(deck, userId, modelDeck.RoleEditor); err != nil {
+		return 0, err
 	}
 
-	err = cm.cardRepository.DeleteCard(cardId)
+	sourceCards, err := cm.cardRepository.ReadDeckCards(sourceDeckId)
 	if err != nil {
+		return 0, err
+	}
+	if len(sourceCards) == 0 {
+		return 0, nil
+	}
 
-		return nil
+	// Oldest first, so copies are introduced in the order of the source deck
+	slices.SortStableFunc(sourceCards, func(a, b model.Card) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	cards := make([]model.Card, 0, len(sourceCards))
+	notes := make(map[uuid.UUID]*uuid.UUID)
+	for _, sourceCard := range sourceCards {
+		// Copies of siblings are siblings of a new note
+		var noteId *uuid.UUID
+		if sourceCard.NoteId != nil {
+			if notes[*sourceCard.NoteId] == nil {
+				id := uuid.New()
+				notes[*sourceCard.NoteId] = &id
+			}
+			noteId = notes[*sourceCard.NoteId]
+		}
+		cards = append(cards, model.Card{
+			CreatedBy:    userId,
+			Word:         sourceCard.Word,
+			Translation:  sourceCard.Translation,
+			Tags:         sourceCard.Tags,
+			DeckID:       deck.DeckId,
+			IsPublic:     deck.IsPublic,
+			SourceCardId: &sourceCard.CardId,
+			Template:     sourceCard.Template,
+			NoteId:       noteId,
+			Ordinal:      sourceCard.Ordinal,
+		})
 	}
 
-	return nil
+	if err := cm.cardRepository.AddDeckCards(deckId, cards); err != nil {
+		return 0, err
+	}
+	return len(cards), nil
 }
 
-func (cm Card) AddAnswers(ctx context.Context, userId uuid.UUID, answers []schemes.AnswerScheme) error {
+// PublishDeck records how cards of the own deck changed since its last version as
+// a new version. Nothing is recorded and the version stays when no card changed
+func (cm Card) PublishDeck(deckId uuid.UUID, userId uuid.UUID) (*schemes.PublishResult, error) {
+	deck, err := cm.cardRepository.ReadDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return nil, ErrDeckNotFound
+	}
+	if err := cm.authorizeDeck(deck, userId, modelDeck.RoleOwner); err != nil {
+		return nil, err
+	}
 
-	for _, answer := range answers {
-		if answer.Grade < 0 || answer.Grade > 5 {
-			return fmt.Errorf("invalid grade")
+	result := &schemes.PublishResult{Version: deck.Version}
+	err = cm.cardRepository.Transaction(func(repo CardRepository) error {
+		cards, err := repo.ReadDeckCards(deckId)
+		if err != nil {
+			return err
 		}
-
-		card, err := cm.cardRepository.ReadCard(answer.CardId)
+		published, err := repo.ReadPublishedCards(deckId)
 		if err != nil {
 			return err
 		}
 
-		// NOTE: If expire_time not reached yet the card will be just skipped
-		if time.Now().Compare(card.ExpiresAt) == -1 {
-			cm.log.Info("Card not expired yet, skipping", "cardId", card.CardId, "expiresAt", card.ExpiresAt)
-			continue
+		changes := diffPublished(published, cards)
+		if len(changes) == 0 {
+			return nil
 		}
 
-		cardOwnerId := card.CreatedBy
-		if userId != cardOwnerId {
-			return fmt.Errorf("invalid card owner. got %v. want %v", cardOwnerId, card.CreatedBy)
+		result.Version = deck.Version + 1
+		now := time.Now()
+		for i := range changes {
+			changes[i].DeckId = deckId
+			changes[i].Version = result.Version
+			changes[i].PublishedAt = now
+			switch changes[i].Action {
+			case model.DeckChangeAdd:
+				result.Added++
+			case model.DeckChangeEdit:
+				result.Edited++
+			case model.DeckChangeDelete:
+				result.Deleted++
+			}
 		}
+		return repo.PublishDeckChanges(deckId, result.Version, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-		// recalculate values
-		reviewResult := sm2.SM2(time.Now(),
-			card.Interval,
-			card.Easiness,
-			card.RepetitionNumber,
-			answer.Grade)
+// diffPublished compares cards of the deck with their last published state
+func diffPublished(published []model.DeckChange, cards []model.Card) []model.DeckChange {
+	last := make(map[uuid.UUID]model.DeckChange, len(published))
+	for _, change := range published {
+		last[change.CardId] = change
+	}
 
-		// write back to db
-		card.UpdatedAt = time.Now()
-		card.ExpiresAt = reviewResult.NextReviewTime
-		card.Easiness = reviewResult.Easiness
-		card.Interval = int(reviewResult.Interval)
-		card.RepetitionNumber = reviewResult.Repetitions
+	// Oldest first, so subscribers get new cards in the order they were added
+	slices.SortStableFunc(cards, func(a, b model.Card) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
 
-		if err = cm.cardRepository.PureUpdate(card); err != nil {
-			return err
+	var changes []model.DeckChange
+	for _, card := range cards {
+		change := model.DeckChange{
+			CardId:      card.CardId,
+			Action:      model.DeckChangeAdd,
+			Word:        card.Word,
+			Translation: card.Translation,
+			Tags:        card.Tags,
+			Template:    card.Template,
+			NoteId:      card.NoteId,
+			Ordinal:     card.Ordinal,
+		}
+		if previous, ok := last[card.CardId]; ok {
+			delete(last, card.CardId)
+			if previous.Word == card.Word && previous.Translation == card.Translation && slices.Equal(previous.Tags, card.Tags) {
+				continue
+			}
+			change.Action = model.DeckChangeEdit
+		}
+		changes = append(changes, change)
+	}
+
+	// Published cards left are no longer in the deck
+	for _, change := range published {
+		if _, ok := last[change.CardId]; ok {
+			changes = append(changes, model.DeckChange{CardId: change.CardId, Action: model.DeckChangeDelete})
+		}
+	}
+	return changes
+}
+
+// ReadDeckChanges returns the changelog of a public or shared deck after the version,
+// oldest first, together with the last published version
+func (cm Card) ReadDeckChanges(deckId uuid.UUID, sinceVersion int, userId uuid.UUID) ([]model.DeckChange, int, error) {
+	deck, err := cm.cardRepository.ReadDeck(deckId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return nil, 0, ErrDeckNotFound
+	}
+	if !deck.IsPublic {
+		if err := cm.authorizeDeck(deck, userId, modelDeck.RoleViewer); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	changes, err := cm.cardRepository.ReadDeckChanges(deckId, max(sinceVersion, 0))
+	if err != nil {
+		return nil, 0, err
+	}
+	return changes, deck.Version, nil
+}
+
+// SyncDeck merges changes of the source deck published since the last sync into
+// the subscribed copy. Added cards start as new with their template, siblings
+// of a note stay siblings. Edits replace content but keep scheduling and
+// deleted cards go to trash. Cards the subscriber edited or deleted are left
+// as they are
+func (cm Card) SyncDeck(deckId uuid.UUID, userId uuid.UUID) (*schemes.DeckSyncResult, error) {
+	deck, err := cm.cardRepository.ReadDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return nil, ErrDeckNotFound
+	}
+	if err := cm.authorizeDeck(deck, userId, modelDeck.RoleOwner); err != nil {
+		return nil, err
+	}
+	if deck.SourceDeckId == nil || !deck.Subscribed {
+		return nil, ErrNotSubscribed
+	}
+
+	source, err := cm.cardRepository.ReadDeck(*deck.SourceDeckId)
+	if err != nil {
+		return nil, err
+	}
+	if source.DeckId == uuid.Nil {
+		return nil, ErrDeckNotFound
+	}
+	if !source.IsPublic {
+		if err := cm.authorizeDeck(source, userId, modelDeck.RoleViewer); err != nil {
+			return nil, err
 		}
+	}
+
+	result := &schemes.DeckSyncResult{Version: source.Version}
+	if deck.SyncedVersion >= source.Version {
+		result.Version = deck.SyncedVersion
+		return result, nil
+	}
 
-		md, _ := metadata.FromIncomingContext(ctx)
-		cm.log.Info("Authorization Metadata", "authorization", md["authorization"])
+	err = cm.cardRepository.Transaction(func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
 
-		reviewId, err := cm.statClient.AddRecord(ctx, card.DeckID.String(), card.CardId.String(), answer.Grade)
+		changes, err := repo.ReadDeckChanges(source.DeckId, deck.SyncedVersion)
+		if err != nil {
+			return err
+		}
+		clones, err := repo.ReadClonedCards(deckId)
+		if err != nil {
+			return err
+		}
+		bySource := make(map[uuid.UUID]model.Card, len(clones))
+		for _, clone := range clones {
+			bySource[*clone.SourceCardId] = clone
+		}
+		latest := latestChanges(changes)
+		notes, err := tx.clonedNotes(latest, bySource)
 		if err != nil {
-			cm.log.Error("Failed to add stat record", "error", err, "reviewId", reviewId)
 			return err
 		}
+
+		var added []model.Card
+		var deleted []uuid.UUID
+		for _, change := range latest {
+			clone, ok := bySource[change.CardId]
+			switch {
+			case !ok && change.Action != model.DeckChangeDelete:
+				added = append(added, syncedCard(change, deck, userId, notes))
+			case !ok:
+				continue
+			case clone.DeletedAt.Valid || clone.KeepLocal:
+				result.Kept++
+			case change.Action == model.DeckChangeDelete:
+				deleted = append(deleted, clone.CardId)
+			default:
+				before := clone
+				clone.Word = change.Word
+				clone.Translation = change.Translation
+				clone.Tags = change.Tags
+				if len(diffCards(&before, &clone)) == 0 {
+					continue
+				}
+				clone.UpdatedAt = time.Now()
+				if err := repo.PureUpdate(&clone); err != nil {
+					return err
+				}
+				// Recorded as an edit of the author of the source deck
+				if err := tx.addRevision(&before, &clone, source.CreatedBy, 0); err != nil {
+					return err
+				}
+				result.Updated++
+			}
+		}
+
+		if len(added) > 0 {
+			if err := repo.AddDeckCards(deckId, added); err != nil {
+				return err
+			}
+			result.Added = len(added)
+		}
+		if len(deleted) > 0 {
+			if err := repo.RemoveDeckCards(deckId, deleted); err != nil {
+				return err
+			}
+			result.Deleted = len(deleted)
+		}
+		return repo.SetSyncedVersion(deckId, source.Version)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return result, nil
+}
+
+// clonedNotes maps notes of the source deck to notes of their copies, so cards
+// added to a source note join siblings cloned or synced before
+func (cm Card) clonedNotes(changes []model.DeckChange, bySource map[uuid.UUID]model.Card) (map[uuid.UUID]*uuid.UUID, error) {
+	notes := make(map[uuid.UUID]*uuid.UUID)
+	added := false
+	for _, change := range changes {
+		if _, ok := bySource[change.CardId]; !ok && change.NoteId != nil {
+			added = true
+		}
+	}
+	var sourceIds []uuid.UUID
+	for sourceId, clone := range bySource {
+		if clone.NoteId != nil {
+			sourceIds = append(sourceIds, sourceId)
+		}
+	}
+	if !added || len(sourceIds) == 0 {
+		return notes, nil
+	}
+
+	sources, err := cm.cardRepository.ReadCards(sourceIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, source := range sources {
+		if source.NoteId != nil {
+			notes[*source.NoteId] = bySource[source.CardId].NoteId
+		}
+	}
+	return notes, nil
+}
+
+// syncedCard is the copy of a card added to the source deck. Siblings of a
+// source note become siblings of one note of the subscriber
+func syncedCard(change model.DeckChange, deck *modelDeck.Deck, userId uuid.UUID, notes map[uuid.UUID]*uuid.UUID) model.Card {
+	card := model.Card{
+		CreatedBy:   userId,
+		Word:        change.Word,
+		Translation: change.Translation,
+		Tags:        change.Tags,
+		DeckID:      deck.DeckId,
+		IsPublic:    deck.IsPublic,
+		Template:    change.Template,
+	}
+	if change.NoteId != nil {
+		if notes[*change.NoteId] == nil {
+			noteId := uuid.New()
+			notes[*change.NoteId] = &noteId
+		}
+		card.NoteId = notes[*change.NoteId]
+	}
+	if change.Template == model.TemplateCloze {
+		card = clozeCard(&card, change.Ordinal)
+	}
+	card.SourceCardId = &change.CardId
+	return card
+}
+
+// latestChanges keeps the last change of every card, in the order cards first changed
+func latestChanges(changes []model.DeckChange) []model.DeckChange {
+	index := make(map[uuid.UUID]int)
+	var latest []model.DeckChange
+	for _, change := range changes {
+		if i, ok := index[change.CardId]; ok {
+			latest[i] = change
+			continue
+		}
+		index[change.CardId] = len(latest)
+		latest = append(latest, change)
+	}
+	return latest
 }
 
-func (cm Card) SearchAllPublicCards() ([]model.Card, error) {
-	cards, err := cm.cardRepository.SearchAllPublicCards()
+// SetKeepLocal stops or resumes merging changes of the source card into the cloned card
+func (cm Card) SetKeepLocal(cardId uuid.UUID, keepLocal bool, userId uuid.UUID) (*model.Card, error) {
+	card, err := cm.accessCard(cardId, userId, modelDeck.RoleEditor)
 	if err != nil {
 		return nil, err
 	}
-	return cards, nil
+	if card.SourceCardId == nil {
+		return nil, ErrNotClonedCard
+	}
+
+	if err := cm.cardRepository.SetKeepLocal(cardId, keepLocal); err != nil {
+		return nil, err
+	}
+	card.KeepLocal = keepLocal
+	return card, nil
 }
 
-func (cm Card) SearchUserPublicCards(userId string) ([]model.Card, error) {
-	userIdParsed, err := uuid.Parse(userId)
+// ImportCards adds cards to the deck from rows pulled from next until it returns io.EOF.
+// Invalid rows and rows repeating a card of the deck or an earlier row are skipped
+// and reported by line. The whole import runs in one transaction, so on failure
+// no card is added
+func (cm Card) ImportCards(deckId uuid.UUID, userId uuid.UUID, next func() ([]schemes.ImportRow, error)) (*schemes.ImportResult, error) {
+	deck, err := cm.cardRepository.ReadDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return nil, ErrDeckNotFound
+	}
+	if err := cm.authorizeDeck(deck, userId, modelDeck.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	var result *schemes.ImportResult
+	err = cm.cardRepository.Transaction(func(repo CardRepository) error {
+		result = &schemes.ImportResult{Errors: []schemes.ImportRowError{}}
+
+		existing, err := repo.ReadDeckCards(deckId)
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(existing))
+		for _, card := range existing {
+			seen[importKey(card.Word, card.Translation)] = true
+		}
+
+		read := 0
+		for {
+			rows, err := next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			read += len(rows)
+			if read > MaxImportRows {
+				return ErrTooManyRows
+			}
+
+			var cards []model.Card
+			var reviewLogs []model.ReviewLog
+			for _, row := range rows {
+				card, err := importCard(row, deck, userId)
+				if err == nil && seen[importKey(card.Word, card.Translation)] {
+					err = ErrDuplicateCard
+					result.Duplicates++
+				}
+				if err != nil {
+					result.Errors = append(result.Errors, schemes.ImportRowError{Line: row.Line, Error: err.Error()})
+					continue
+				}
+				seen[importKey(card.Word, card.Translation)] = true
+				cards = append(cards, *card)
+				reviewLogs = append(reviewLogs, importReviewLogs(card, row.Reviews)...)
+			}
+			if len(cards) == 0 {
+				continue
+			}
+
+			if err := repo.AddDeckCards(deckId, cards); err != nil {
+				return err
+			}
+			if len(reviewLogs) > 0 {
+				if err := repo.AddReviewLogs(reviewLogs); err != nil {
+					return err
+				}
+			}
+			result.Imported += len(cards)
+		}
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %v", err)
+		return nil, err
 	}
+	return result, nil
+}
 
-	if userIdParsed == uuid.Nil {
-		return nil, fmt.Errorf("user ID cannot be empty")
+// importCard validates the row and makes a new card of the deck from it
+func importCard(row schemes.ImportRow, deck *modelDeck.Deck, userId uuid.UUID) (*model.Card, error) {
+	word := strings.TrimSpace(row.Word)
+	translation := strings.TrimSpace(row.Translation)
+	if word == "" {
+		return nil, ErrWordRequired
+	}
+	if translation == "" {
+		return nil, ErrTranslationRequired
+	}
+	if utf8.RuneCountInString(word) > MaxFieldLength || utf8.RuneCountInString(translation) > MaxFieldLength {
+		return nil, ErrFieldTooLong
 	}
 
-	cards, err := cm.cardRepository.SearchUserPublicCards(userIdParsed)
+	tags, err := normalizeTags(row.Tags)
 	if err != nil {
 		return nil, err
 	}
-	return cards, nil
+
+	now := time.Now()
+	for _, review := range row.Reviews {
+		if review.Grade < 0 || review.Grade > 5 || review.ReviewedAt.IsZero() || review.ReviewedAt.After(now.Add(clockSkew)) {
+			return nil, ErrInvalidReview
+		}
+	}
+
+	// Id is set up front, so imported history can refer to the card
+	card := &model.Card{
+		CardId:      uuid.New(),
+		CreatedBy:   userId,
+		Word:        word,
+		Translation: translation,
+		DeckID:      deck.DeckId,
+		IsPublic:    deck.IsPublic,
+		Tags:        tags,
+	}
+	if row.Schedule != nil {
+		if err := applySchedule(card, row.Schedule); err != nil {
+			return nil, err
+		}
+	}
+	return card, nil
+}
+
+// applySchedule carries scheduling state of an imported card over. Zero values
+// keep defaults of a new card, so partial state is still usable
+func applySchedule(card *model.Card, schedule *schemes.ImportSchedule) error {
+	switch scheduler.Phase(schedule.Phase) {
+	case scheduler.PhaseLearning, scheduler.PhaseReview, scheduler.PhaseRelearning:
+	default:
+		return ErrInvalidSchedule
+	}
+
+	card.Phase = schedule.Phase
+	card.Interval = max(schedule.Interval, 0)
+	if schedule.Easiness > 0 {
+		card.Easiness = max(schedule.Easiness, minImportEasiness)
+	}
+	card.RepetitionNumber = max(schedule.RepetitionNumber, 0)
+	card.Lapses = max(schedule.Lapses, 0)
+	card.ExpiresAt = schedule.ExpiresAt
+	card.LastReviewedAt = schedule.LastReviewedAt
+	card.Stability = max(schedule.Stability, 0)
+	card.Difficulty = max(schedule.Difficulty, 0)
+	return nil
+}
+
+// importReviewLogs makes history of the imported card from its reviews. Review
+// logs keep state of the card before each answer, as when a card is answered
+func importReviewLogs(card *model.Card, reviews []schemes.Review) []model.ReviewLog {
+	reviewLogs := make([]model.ReviewLog, 0, len(reviews))
+	for _, review := range reviews {
+		reviewLogs = append(reviewLogs, model.ReviewLog{
+			UserId:     card.CreatedBy,
+			CardId:     card.CardId,
+			DeckId:     card.DeckID,
+			Grade:      review.Grade,
+			ReviewedAt: review.ReviewedAt,
+			Phase:      review.Phase,
+			Interval:   review.Interval,
+			Easiness:   review.Easiness,
+			Stability:  review.Stability,
+			Difficulty: review.Difficulty,
+		})
+	}
+	return reviewLogs
+}
+
+// ReadDeckReviews returns history of the user's answers to cards of a shared deck, oldest first
+func (cm Card) ReadDeckReviews(deckId uuid.UUID, userId uuid.UUID) ([]schemes.Review, error) {
+	deck, err := cm.cardRepository.ReadDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return nil, ErrDeckNotFound
+	}
+	if err := cm.authorizeDeck(deck, userId, modelDeck.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	reviewLogs, err := cm.cardRepository.ReadDeckReviewLogs(deckId, userId)
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]schemes.Review, 0, len(reviewLogs))
+	for _, reviewLog := range reviewLogs {
+		reviews = append(reviews, schemes.Review{
+			CardId:     reviewLog.CardId,
+			Grade:      reviewLog.Grade,
+			ReviewedAt: reviewLog.ReviewedAt,
+			Phase:      reviewLog.Phase,
+			Interval:   reviewLog.Interval,
+			Easiness:   reviewLog.Easiness,
+			Stability:  reviewLog.Stability,
+			Difficulty: reviewLog.Difficulty,
+		})
+	}
+	return reviews, nil
+}
+
+// importKey identifies a card for de-duplication, ignoring case and surrounding spaces
+func importKey(word string, translation string) string {
+	return strings.ToLower(strings.TrimSpace(word)) + "\x00" + strings.ToLower(strings.TrimSpace(translation))
+}
+
+// UpdateCard applies the update and records the change in card history.
+// Tags are replaced when given. Word, translation and tags are shared with
+// siblings of the card, they change and get a history entry too. Editing the
+// word of a cloze card adds cards for new cloze indexes and moves cards of
+// removed ones to trash
+func (cm Card) UpdateCard(cardId uuid.UUID, cardUpdate *schemes.UpdateCardScheme, userId uuid.UUID) (*model.Card, error) {
+	if cardUpdate.Tags != nil {
+		tags, err := normalizeTags(cardUpdate.Tags)
+		if err != nil {
+			return nil, err
+		}
+		// Non-nil even when empty, so blank tags clear tags of the card
+		cardUpdate.Tags = append([]string{}, tags...)
+	}
+
+	var cardUpdated *model.Card
+	err := cm.cardRepository.Transaction(func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+
+		cardFound, err := repo.ReadCard(cardId)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.authorizeCard(cardFound, userId, modelDeck.RoleEditor); err != nil {
+			return err
+		}
+		if cardFound.Template == model.TemplateCloze && cardUpdate.Word != "" && len(model.ClozeIndexes(cardUpdate.Word)) == 0 {
+			return ErrInvalidCloze
+		}
+
+		before := *cardFound
+		cardUpdated, err = repo.UpdateCard(cardFound, cardUpdate)
+		if err != nil {
+			return err
+		}
+
+		// Content edited by the user is not overwritten by changes of the source card
+		if cardUpdated.SourceCardId != nil && !cardUpdated.KeepLocal && contentChanged(&before, cardUpdated) {
+			if err := repo.SetKeepLocal(cardId, true); err != nil {
+				return err
+			}
+			cardUpdated.KeepLocal = true
+		}
+
+		if err := tx.addRevision(&before, cardUpdated, userId, 0); err != nil {
+			return err
+		}
+
+		if cardUpdated.NoteId == nil || !contentChanged(&before, cardUpdated) {
+			return nil
+		}
+		siblings, err := repo.ReadSiblings(*cardUpdated.NoteId, cardId)
+		if err != nil {
+			return err
+		}
+		for _, sibling := range siblings {
+			siblingBefore := sibling
+			sibling.Word = cardUpdated.Word
+			sibling.Translation = cardUpdated.Translation
+			sibling.Tags = cardUpdated.Tags
+			sibling.UpdatedAt = time.Now()
+			if err := repo.PureUpdate(&sibling); err != nil {
+				return err
+			}
+			if err := tx.addRevision(&siblingBefore, &sibling, userId, 0); err != nil {
+				return err
+			}
+		}
+		if cardUpdated.Template == model.TemplateCloze {
+			return tx.syncClozeNote(cardUpdated, siblings)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cardUpdated, nil
+}
+
+// syncClozeNote makes cards of the cloze note match cloze indexes of its word:
+// indexes without a card get a new one, cards of indexes no longer used are
+// moved to trash with their scheduling
+func (cm Card) syncClozeNote(card *model.Card, siblings []model.Card) error {
+	indexes := model.ClozeIndexes(card.Word)
+	items := append([]model.Card{*card}, siblings...)
+
+	asked := make(map[int]bool, len(items))
+	for _, item := range items {
+		asked[item.Ordinal] = true
+	}
+	for _, index := range indexes {
+		if asked[index] {
+			continue
+		}
+		added := clozeCard(card, index)
+		if err := cm.cardRepository.AddCard(&added); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		if slices.Contains(indexes, item.Ordinal) {
+			continue
+		}
+		if err := cm.cardRepository.DeleteCard(item.CardId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadCardHistory returns revisions of the card, oldest first
+func (cm Card) ReadCardHistory(cardId uuid.UUID, userId uuid.UUID) ([]model.CardRevision, error) {
+	if _, err := cm.accessCard(cardId, userId, modelDeck.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return cm.cardRepository.ReadCardRevisions(cardId)
+}
+
+// RevertCard restores word, translation, tags and visibility of the card as they
+// were at the revision. Scheduling is kept. The revert is recorded as a new revision
+func (cm Card) RevertCard(cardId uuid.UUID, revision int, userId uuid.UUID) (*model.Card, error) {
+	var card *model.Card
+	err := cm.cardRepository.Transaction(func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+
+		var err error
+		card, err = tx.accessCard(cardId, userId, modelDeck.RoleEditor)
+		if err != nil {
+			return err
+		}
+
+		target, err := repo.ReadCardRevision(cardId, revision)
+		if err != nil {
+			return err
+		}
+		if target.RevisionId == uuid.Nil {
+			return ErrRevisionNotFound
+		}
+
+		before := *card
+		card.Word = target.Word
+		card.Translation = target.Translation
+		card.Tags = target.Tags
+		card.IsPublic = target.IsPublic
+		if len(diffCards(&before, card)) == 0 {
+			return nil
+		}
+
+		card.UpdatedAt = time.Now()
+		if err := repo.PureUpdate(card); err != nil {
+			return err
+		}
+
+		return tx.addRevision(&before, card, userId, revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// accessCard reads the card if the user wrote it or has the required role in its deck
+func (cm Card) accessCard(cardId uuid.UUID, userId uuid.UUID, required string) (*model.Card, error) {
+	card, err := cm.cardRepository.ReadCard(cardId)
+	if err != nil {
+		return nil, err
+	}
+	if card.CardId == uuid.Nil {
+		return nil, ErrCardNotFound
+	}
+	if err := cm.authorizeCard(card, userId, required); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// authorizeCard checks the user wrote the card or has the required role in its deck
+func (cm Card) authorizeCard(card *model.Card, userId uuid.UUID, required string) error {
+	if card.CreatedBy == userId {
+		return nil
+	}
+	if card.DeckID == uuid.Nil {
+		return ErrNotCardOwner
+	}
+	deck, err := cm.cardRepository.ReadDeck(card.DeckID)
+	if err != nil {
+		return err
+	}
+	if err := cm.authorizeDeck(deck, userId, required); err != nil {
+		if errors.Is(err, ErrNotDeckOwner) {
+			return ErrNotCardOwner
+		}
+		return err
+	}
+	return nil
+}
+
+// authorizeDeck checks the role of the user in the deck grants the required one.
+// The author of the deck owns it, other users get roles as deck members
+func (cm Card) authorizeDeck(deck *modelDeck.Deck, userId uuid.UUID, required string) error {
+	if deck.DeckId == uuid.Nil {
+		return ErrNotDeckOwner
+	}
+	if deck.CreatedBy == userId {
+		return nil
+	}
+	role, err := cm.cardRepository.ReadMemberRole(deck.DeckId, userId)
+	if err != nil {
+		return err
+	}
+	if !modelDeck.RoleAllows(role, required) {
+		return ErrNotDeckOwner
+	}
+	return nil
+}
+
+// addRevision records the change from before to after. Cards edited for the
+// first time get a baseline revision with their content before the edit, so
+// every revision of the history can be reverted to
+func (cm Card) addRevision(before, after *model.Card, userId uuid.UUID, revertedFrom int) error {
+	changes := diffCards(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	revisions, err := cm.cardRepository.ReadCardRevisions(before.CardId)
+	if err != nil {
+		return err
+	}
+
+	next := 1
+	if len(revisions) == 0 {
+		baseline := revisionOf(before, before.CreatedBy, before.CreatedAt)
+		baseline.Revision = next
+		if err := cm.cardRepository.AddCardRevision(baseline); err != nil {
+			return err
+		}
+		next++
+	} else {
+		next = revisions[len(revisions)-1].Revision + 1
+	}
+
+	revision := revisionOf(after, userId, time.Now())
+	revision.Revision = next
+	revision.Changes = changes
+	revision.RevertedFrom = revertedFrom
+	return cm.cardRepository.AddCardRevision(revision)
+}
+
+func revisionOf(card *model.Card, editedBy uuid.UUID, editedAt time.Time) *model.CardRevision {
+	return &model.CardRevision{
+		CardId:      card.CardId,
+		EditedBy:    editedBy,
+		EditedAt:    editedAt,
+		Word:        card.Word,
+		Translation: card.Translation,
+		Tags:        card.Tags,
+		IsPublic:    card.IsPublic,
+	}
+}
+
+func contentChanged(before, after *model.Card) bool {
+	return before.Word != after.Word || before.Translation != after.Translation || !slices.Equal(before.Tags, after.Tags)
+}
+
+// diffCards lists fields an edit can change that differ between two states of a card
+func diffCards(before, after *model.Card) []model.FieldChange {
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"word", before.Word, after.Word},
+		{"translation", before.Translation, after.Translation},
+		{"tags", strings.Join(before.Tags, ","), strings.Join(after.Tags, ",")},
+		{"is_public", strconv.FormatBool(before.IsPublic), strconv.FormatBool(after.IsPublic)},
+		{"easiness", strconv.FormatFloat(before.Easiness, 'f', -1, 64), strconv.FormatFloat(after.Easiness, 'f', -1, 64)},
+		{"interval", strconv.Itoa(before.Interval), strconv.Itoa(after.Interval)},
+		{"repetition_number", strconv.Itoa(before.RepetitionNumber), strconv.Itoa(after.RepetitionNumber)},
+		{"expires_at", before.ExpiresAt.UTC().Format(time.RFC3339), after.ExpiresAt.UTC().Format(time.RFC3339)},
+	}
+
+	var changes []model.FieldChange
+	for _, field := range fields {
+		if field.from != field.to {
+			changes = append(changes, model.FieldChange{Field: field.name, OldValue: field.from, NewValue: field.to})
+		}
+	}
+	return changes
+}
+
+// RetagCards adds and removes tags on cards of the user in one transaction,
+// a tag both added and removed ends up removed. Each changed card gets a
+// revision in its history
+func (cm Card) RetagCards(cardIds []uuid.UUID, add, remove []string, userId uuid.UUID) ([]model.Card, error) {
+	if len(cardIds) == 0 {
+		return nil, ErrNoCardsSelected
+	}
+	add, err := normalizeTags(add)
+	if err != nil {
+		return nil, err
+	}
+	remove, err = normalizeTags(remove)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards []model.Card
+	err = cm.cardRepository.Transaction(func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+
+		found, err := repo.ReadCards(cardIds)
+		if err != nil {
+			return err
+		}
+		byId := make(map[uuid.UUID]model.Card, len(found))
+		for _, card := range found {
+			byId[card.CardId] = card
+		}
+
+		for _, cardId := range cardIds {
+			card, ok := byId[cardId]
+			if !ok {
+				return ErrCardNotFound
+			}
+			if err := tx.authorizeCard(&card, userId, modelDeck.RoleEditor); err != nil {
+				return err
+			}
+
+			err := tx.retag(&card, func(tags []string) []string {
+				return slices.DeleteFunc(slices.Concat(tags, add), func(tag string) bool {
+					return slices.Contains(remove, tag)
+				})
+			}, userId)
+			if err != nil {
+				return err
+			}
+			byId[cardId] = card
+		}
+
+		for _, cardId := range cardIds {
+			cards = append(cards, byId[cardId])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// ReadTags returns tags of the user with the number of cards having each
+func (cm Card) ReadTags(userId uuid.UUID) ([]model.TagCount, error) {
+	return cm.cardRepository.ReadTagCounts(userId)
+}
+
+// RenameTag renames the tag on every card of the user. Cards already having a
+// tag of the new name keep one copy of it. Returns the number of cards changed
+func (cm Card) RenameTag(tag string, newName string, userId uuid.UUID) (int64, error) {
+	from, err := normalizeTag(tag)
+	if err != nil {
+		return 0, err
+	}
+	to, err := normalizeTag(newName)
+	if err != nil {
+		return 0, err
+	}
+	if from == to {
+		return 0, nil
+	}
+
+	var renamed int64
+	err = cm.cardRepository.Transaction(func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+
+		cards, err := repo.ReadCardsWithTag(userId, from)
+		if err != nil {
+			return err
+		}
+
+		for _, card := range cards {
+			err := tx.retag(&card, func(tags []string) []string {
+				return slices.Concat(slices.DeleteFunc(tags, func(t string) bool { return t == from }), []string{to})
+			}, userId)
+			if err != nil {
+				return err
+			}
+			renamed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return renamed, nil
+}
+
+// retag sets tags of the card to the result of change and records the change
+// in card history. Change gets a copy of the current tags
+func (cm Card) retag(card *model.Card, change func(tags []string) []string, userId uuid.UUID) error {
+	tags, err := normalizeTags(change(slices.Clone(card.Tags)))
+	if err != nil {
+		return err
+	}
+
+	before := *card
+	card.Tags = tags
+	if len(diffCards(&before, card)) == 0 {
+		return nil
+	}
+
+	card.UpdatedAt = time.Now()
+	if err := cm.cardRepository.PureUpdate(card); err != nil {
+		return err
+	}
+
+	return cm.addRevision(&before, card, userId, 0)
+}
+
+// normalizeTags makes the same tag always spelled the same way: tags are
+// trimmed and lowercased, empty and repeated ones are dropped and the rest is sorted
+func normalizeTags(tags []string) ([]string, error) {
+	var normalized []string
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+		tag, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, tag)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength || strings.ContainsFunc(tag, unicode.IsSpace) {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// normalizeFilterTag normalizes an optional tag filter, empty means no filter
+func normalizeFilterTag(tag string) (string, error) {
+	if tag == "" {
+		return "", nil
+	}
+	return normalizeTag(tag)
+}
+
+// DeleteCard moves the card to trash. It is purged after the retention period
+func (cm Card) DeleteCard(cardId uuid.UUID, userId uuid.UUID) error {
+	cardFound, err := cm.cardRepository.ReadCard(cardId)
+	if err != nil {
+		return err
+	}
+
+	if cardFound == nil || reflect.DeepEqual(cardFound, &model.Card{}) {
+		return fmt.Errorf("card not found")
+	}
+
+	if err := cm.authorizeCard(cardFound, userId, modelDeck.RoleEditor); err != nil {
+		return err
+	}
+
+	return cm.cardRepository.DeleteCard(cardId)
+}
+
+// AddAnswers reschedules answered cards. The whole batch is applied in one
+// transaction together with outbox events for stats, so either every answer
+// is saved and eventually delivered or none is.
+// Answers with a request ID already processed for the user are not applied
+// again, their original result is returned instead
+func (cm Card) AddAnswers(ctx context.Context, userId uuid.UUID, answers []schemes.AnswerScheme) ([]schemes.AnswerResult, error) {
+	algorithm, err := cm.algorithmFor(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []schemes.AnswerResult
+	addAnswers := func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+		results = nil
+		for _, answer := range answers {
+			result, err := tx.addAnswer(userId, algorithm, answer)
+			if err != nil {
+				return err
+			}
+			results = append(results, *result)
+		}
+		return nil
+	}
+	err = cm.cardRepository.Transaction(addAnswers)
+	// A concurrent request stored an answer with the same request ID first. The
+	// transaction is rolled back and the second run returns the stored result
+	if errors.Is(err, ErrAnswerProcessed) {
+		err = cm.cardRepository.Transaction(addAnswers)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (cm Card) addAnswer(userId uuid.UUID, algorithm scheduler.Algorithm, answer schemes.AnswerScheme) (*schemes.AnswerResult, error) {
+	if answer.Grade < 0 || answer.Grade > 5 {
+		return nil, fmt.Errorf("invalid grade")
+	}
+
+	card, err := cm.cardRepository.ReadCard(answer.CardId)
+	if err != nil {
+		return nil, err
+	}
+
+	cardOwnerId := card.CreatedBy
+	if userId != cardOwnerId {
+		return nil, fmt.Errorf("invalid card owner. got %v. want %v", cardOwnerId, userId)
+	}
+
+	if answer.RequestId != "" {
+		processed, err := cm.cardRepository.ReadProcessedAnswer(userId, answer.RequestId)
+		if err != nil {
+			return nil, err
+		}
+		if processed.RequestId != "" {
+			cm.log.Info("Answer already processed, skipping", "requestId", answer.RequestId, "cardId", processed.CardId)
+			return &schemes.AnswerResult{
+				CardId:       processed.CardId,
+				RequestId:    processed.RequestId,
+				ReviewId:     processed.ReviewId,
+				NextReviewAt: processed.NextReviewAt,
+				Duplicate:    true,
+			}, nil
+		}
+	}
+
+	result := &schemes.AnswerResult{
+		CardId:       card.CardId,
+		RequestId:    answer.RequestId,
+		NextReviewAt: card.ExpiresAt,
+	}
+
+	// NOTE: If expire_time not reached yet the card will be just skipped
+	if time.Now().Compare(card.ExpiresAt) == -1 {
+		cm.log.Info("Card not expired yet, skipping", "cardId", card.CardId, "expiresAt", card.ExpiresAt)
+		return result, cm.rememberAnswer(userId, result)
+	}
+	if card.BuriedUntil != nil && time.Now().Before(*card.BuriedUntil) {
+		cm.log.Info("Card buried after a sibling was reviewed, skipping", "cardId", card.CardId, "buriedUntil", card.BuriedUntil)
+		return result, cm.rememberAnswer(userId, result)
+	}
+
+	reviewId, err := cm.review(userId, algorithm, card, answer.Grade, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result.ReviewId = reviewId
+	result.NextReviewAt = card.ExpiresAt
+	return result, cm.rememberAnswer(userId, result)
+}
+
+// SyncAnswers replays answers given offline in the order they were given, each
+// scheduled at its own answer time rather than now and regardless of whether the
+// card was due. Conflicts with the server state are resolved per answer:
+// answers to deleted cards and answers older than the last server review are
+// dropped, answers to cards edited on the server afterwards are applied on top
+// of the edit. Returns the resulting state of every card touched
+func (cm Card) SyncAnswers(ctx context.Context, userId uuid.UUID, answers []schemes.AnswerScheme) (*schemes.SyncResult, error) {
+	now := time.Now()
+	for _, answer := range answers {
+		if answer.Grade < 0 || answer.Grade > 5 {
+			return nil, fmt.Errorf("invalid grade")
+		}
+		if answer.AnsweredAt.IsZero() || answer.AnsweredAt.After(now.Add(clockSkew)) {
+			return nil, ErrInvalidAnswerTime
+		}
+	}
+
+	ordered := make([]schemes.AnswerScheme, len(answers))
+	copy(ordered, answers)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].AnsweredAt.Before(ordered[j].AnsweredAt)
+	})
+
+	algorithm, err := cm.algorithmFor(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *schemes.SyncResult
+	syncAnswers := func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+		result = &schemes.SyncResult{}
+		touched := make(map[uuid.UUID]bool)
+		var cardIds []uuid.UUID
+
+		for _, answer := range ordered {
+			answerResult, conflict, err := tx.syncAnswer(userId, algorithm, answer)
+			if err != nil {
+				return err
+			}
+			result.Results = append(result.Results, *answerResult)
+			if conflict != nil {
+				result.Conflicts = append(result.Conflicts, *conflict)
+			}
+			if (conflict == nil || conflict.Reason != ConflictDeleted) && !touched[answerResult.CardId] {
+				touched[answerResult.CardId] = true
+				cardIds = append(cardIds, answerResult.CardId)
+			}
+		}
+
+		for _, cardId := range cardIds {
+			card, err := repo.ReadCard(cardId)
+			if err != nil {
+				return err
+			}
+			if card.CardId != uuid.Nil {
+				result.Cards = append(result.Cards, *card)
+			}
+		}
+		return nil
+	}
+	err = cm.cardRepository.Transaction(syncAnswers)
+	// Same as in AddAnswers, a concurrent request stored an answer first
+	if errors.Is(err, ErrAnswerProcessed) {
+		err = cm.cardRepository.Transaction(syncAnswers)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (cm Card) syncAnswer(userId uuid.UUID, algorithm scheduler.Algorithm, answer schemes.AnswerScheme) (*schemes.AnswerResult, *schemes.SyncConflict, error) {
+	card, err := cm.cardRepository.ReadCard(answer.CardId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if card.CardId != uuid.Nil && card.CreatedBy != userId {
+		return nil, nil, fmt.Errorf("invalid card owner. got %v. want %v", card.CreatedBy, userId)
+	}
+
+	if answer.RequestId != "" {
+		processed, err := cm.cardRepository.ReadProcessedAnswer(userId, answer.RequestId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if processed.RequestId != "" {
+			return &schemes.AnswerResult{
+				CardId:       processed.CardId,
+				RequestId:    processed.RequestId,
+				ReviewId:     processed.ReviewId,
+				NextReviewAt: processed.NextReviewAt,
+				Duplicate:    true,
+			}, nil, nil
+		}
+	}
+
+	result := &schemes.AnswerResult{
+		CardId:       answer.CardId,
+		RequestId:    answer.RequestId,
+		NextReviewAt: card.ExpiresAt,
+	}
+
+	var conflict *schemes.SyncConflict
+	if reason := syncConflict(card, answer.AnsweredAt); reason != "" {
+		cm.log.Info("Offline answer conflicts with server state", "cardId", answer.CardId, "reason", reason)
+		conflict = &schemes.SyncConflict{CardId: answer.CardId, RequestId: answer.RequestId, Reason: reason}
+		if reason != ConflictEdited {
+			return result, conflict, cm.rememberAnswer(userId, result)
+		}
+	}
+
+	reviewId, err := cm.review(userId, algorithm, card, answer.Grade, answer.AnsweredAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result.ReviewId = reviewId
+	result.NextReviewAt = card.ExpiresAt
+	return result, conflict, cm.rememberAnswer(userId, result)
+}
+
+// syncConflict tells why an answer given at answeredAt cannot be replayed as is
+func syncConflict(card *model.Card, answeredAt time.Time) string {
+	switch {
+	case card.CardId == uuid.Nil:
+		return ConflictDeleted
+	case card.LastReviewedAt != nil && card.LastReviewedAt.After(answeredAt):
+		return ConflictSuperseded
+	case card.UpdatedAt.After(answeredAt):
+		return ConflictEdited
+	}
+	return ""
+}
+
+// review reschedules the card as answered with grade at the given time and
+// records the review for undo, daily limits and stats. It returns the review ID
+func (cm Card) review(userId uuid.UUID, algorithm scheduler.Algorithm, card *model.Card, grade int, now time.Time) (string, error) {
+	options, err := cm.deckOptions(card.DeckID)
+	if err != nil {
+		return "", err
+	}
+
+	sched, err := schedulerFor(algorithm, options)
+	if err != nil {
+		return "", err
+	}
+
+	// recalculate values
+	reviewLog := reviewLogFromCard(card, grade, now)
+	reviewResult := sched.Schedule(now, stateFromCard(card), grade)
+
+	// write back to db, UpdatedAt stays the time of the last edit
+	card.ExpiresAt = reviewResult.NextReviewTime
+	applyState(card, reviewResult.State)
+	card.Algorithm = string(sched.Algorithm())
+
+	if err = cm.cardRepository.PureUpdate(card); err != nil {
+		return "", err
+	}
+
+	// Siblings wait for the next study day
+	if options.BurySiblings && card.NoteId != nil {
+		until := studyDay(now).AddDate(0, 0, 1)
+		if err = cm.cardRepository.BurySiblings(*card.NoteId, card.CardId, &until); err != nil {
+			return "", err
+		}
+	}
+
+	if err = cm.recordProgress(userId, card.DeckID, scheduler.Phase(reviewLog.Phase), now, 1); err != nil {
+		return "", err
+	}
+
+	// Review id is chosen here, it is the idempotency key of delivery to stats
+	reviewId := uuid.New()
+	reviewLog.ReviewId = reviewId.String()
+	if err = cm.cardRepository.AddReviewLog(reviewLog); err != nil {
+		return "", err
+	}
+
+	err = cm.cardRepository.AddOutboxEvent(&model.OutboxEvent{
+		Kind:          model.OutboxReviewAdded,
+		UserId:        userId,
+		DeckId:        card.DeckID,
+		CardId:        card.CardId,
+		ReviewId:      reviewId,
+		Grade:         grade,
+		OccurredAt:    now,
+		NextAttemptAt: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return reviewLog.ReviewId, nil
+}
+
+// rememberAnswer stores the result of an answer submitted with a request ID.
+// Returns ErrAnswerProcessed when a result for the request ID is already stored
+func (cm Card) rememberAnswer(userId uuid.UUID, result *schemes.AnswerResult) error {
+	if result.RequestId == "" {
+		return nil
+	}
+
+	return cm.cardRepository.AddProcessedAnswer(&model.ProcessedAnswer{
+		UserId:       userId,
+		RequestId:    result.RequestId,
+		CardId:       result.CardId,
+		ReviewId:     result.ReviewId,
+		NextReviewAt: result.NextReviewAt,
+		CreatedAt:    time.Now(),
+	})
+}
+
+// UndoLastAnswer restores the card answered last by the user to its state
+// before the answer and queues removal of the review from stats
+func (cm Card) UndoLastAnswer(ctx context.Context, userId uuid.UUID) (*model.Card, error) {
+	var card *model.Card
+	err := cm.cardRepository.Transaction(func(repo CardRepository) error {
+		tx := Card{log: cm.log, cardRepository: repo}
+
+		reviewLog, err := repo.ReadLastReviewLog(userId)
+		if err != nil {
+			return err
+		}
+		if reviewLog.ReviewLogId == uuid.Nil {
+			return ErrNothingToUndo
+		}
+
+		card, err = repo.ReadCard(reviewLog.CardId)
+		if err != nil {
+			return err
+		}
+		if card.CardId == uuid.Nil {
+			return ErrCardNotFound
+		}
+
+		restoreCard(card, reviewLog)
+		if err = repo.PureUpdate(card); err != nil {
+			return err
+		}
+		if card.NoteId != nil {
+			if err = repo.BurySiblings(*card.NoteId, card.CardId, nil); err != nil {
+				return err
+			}
+		}
+
+		if err = tx.recordProgress(userId, reviewLog.DeckId, scheduler.Phase(reviewLog.Phase), reviewLog.ReviewedAt, -1); err != nil {
+			return err
+		}
+
+		if err = repo.DeleteReviewLog(reviewLog.ReviewLogId); err != nil {
+			return err
+		}
+
+		reviewId, err := uuid.Parse(reviewLog.ReviewId)
+		if err != nil {
+			return fmt.Errorf("invalid review id of the answer: %w", err)
+		}
+
+		now := time.Now()
+		return repo.AddOutboxEvent(&model.OutboxEvent{
+			Kind:          model.OutboxReviewDeleted,
+			UserId:        userId,
+			DeckId:        reviewLog.DeckId,
+			CardId:        reviewLog.CardId,
+			ReviewId:      reviewId,
+			Grade:         reviewLog.Grade,
+			OccurredAt:    now,
+			NextAttemptAt: now,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// SearchAllPublicCards lists public cards newest first or, given a full-text
+// query, the matching ones ranked by relevance
+func (cm Card) SearchAllPublicCards(query string, page pagination.Page) ([]model.Card, string, error) {
+	cards, err := cm.cardRepository.SearchAllPublicCards(strings.TrimSpace(query), page)
+	if err != nil {
+		return nil, "", err
+	}
+	cards, next := pagination.Trim(cards, page, cardCursor)
+	return cards, next, nil
+}
+
+func (cm Card) SearchUserPublicCards(userId string, query string, page pagination.Page) ([]model.Card, string, error) {
+	userIdParsed, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid user ID: %v", err)
+	}
+
+	if userIdParsed == uuid.Nil {
+		return nil, "", fmt.Errorf("user ID cannot be empty")
+	}
+
+	cards, err := cm.cardRepository.SearchUserPublicCards(userIdParsed, strings.TrimSpace(query), page)
+	if err != nil {
+		return nil, "", err
+	}
+	cards, next := pagination.Trim(cards, page, cardCursor)
+	return cards, next, nil
+}
+
+func (cm Card) ReadPreferences(userId uuid.UUID) (*model.Preference, error) {
+	preference, err := cm.cardRepository.ReadPreference(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// User has never changed preferences yet
+	if preference.UserId == uuid.Nil {
+		preference.UserId = userId
+		preference.Algorithm = string(scheduler.Default)
+	}
+	return preference, nil
+}
+
+func (cm Card) UpdatePreferences(userId uuid.UUID, algorithm string) (*model.Preference, error) {
+	parsed, err := scheduler.Parse(algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", err, algorithm)
+	}
+
+	preference := &model.Preference{
+		UserId:    userId,
+		Algorithm: string(parsed),
+	}
+	if err := cm.cardRepository.UpsertPreference(preference); err != nil {
+		return nil, err
+	}
+	return preference, nil
+}
+
+func (cm Card) algorithmFor(userId uuid.UUID) (scheduler.Algorithm, error) {
+	preference, err := cm.ReadPreferences(userId)
+	if err != nil {
+		return "", err
+	}
+	return scheduler.Parse(preference.Algorithm)
+}
+
+// recordProgress counts introduced new cards and done reviews towards daily
+// limits of the deck. Negative delta takes an undone answer back
+func (cm Card) recordProgress(userId, deckId uuid.UUID, phase scheduler.Phase, now time.Time, delta int) error {
+	progress := &model.DailyProgress{
+		UserId: userId,
+		DeckId: deckId,
+		Day:    studyDay(now),
+	}
+	switch phase {
+	case scheduler.PhaseNew, "":
+		progress.NewIntroduced = delta
+	case scheduler.PhaseReview:
+		progress.ReviewsDone = delta
+	default:
+		return nil
+	}
+	return cm.cardRepository.AddDailyProgress(progress)
+}
+
+// deckOptions reads study settings of the deck. Cards without deck or decks
+// without saved options use defaults
+func (cm Card) deckOptions(deckId uuid.UUID) (modelDeck.Options, error) {
+	options := modelDeck.DefaultOptions(deckId)
+	if deckId == uuid.Nil {
+		return options, nil
+	}
+
+	saved, err := cm.cardRepository.ReadDeckOptions(deckId)
+	if err != nil {
+		return options, err
+	}
+	if saved.DeckId != uuid.Nil {
+		options = *saved
+	}
+	return options, nil
+}
+
+// schedulerFor builds scheduler with learning steps of the deck. Algorithm
+// set on the deck takes precedence over the user preference
+func schedulerFor(algorithm scheduler.Algorithm, options modelDeck.Options) (scheduler.Scheduler, error) {
+	if options.Algorithm != "" {
+		deckAlgorithm, err := scheduler.Parse(options.Algorithm)
+		if err != nil {
+			return nil, err
+		}
+		algorithm = deckAlgorithm
+	}
+	return scheduler.New(algorithm, configFromOptions(options))
+}
+
+func configFromOptions(options modelDeck.Options) scheduler.Config {
+	return scheduler.Config{
+		LearningSteps:      minutes(options.LearningSteps),
+		RelearningSteps:    minutes(options.RelearningSteps),
+		GraduatingInterval: options.GraduatingInterval,
+		EasyInterval:       options.EasyInterval,
+		MaxInterval:        options.MaximumInterval,
+	}
+}
+
+func minutes(steps []int64) []time.Duration {
+	durations := make([]time.Duration, len(steps))
+	for i, step := range steps {
+		durations[i] = time.Duration(step) * time.Minute
+	}
+	return durations
+}
+
+func stateFromCard(card *model.Card) scheduler.State {
+	phase := scheduler.Phase(card.Phase)
+	if phase == "" {
+		phase = scheduler.PhaseNew
+	}
+
+	return scheduler.State{
+		Phase:       phase,
+		Step:        card.Step,
+		Interval:    card.Interval,
+		Easiness:    card.Easiness,
+		Repetitions: card.RepetitionNumber,
+		Stability:   card.Stability,
+		Difficulty:  card.Difficulty,
+		Lapses:      card.Lapses,
+		LastReview:  card.LastReviewedAt,
+	}
+}
+
+func reviewLogFromCard(card *model.Card, grade int, now time.Time) *model.ReviewLog {
+	return &model.ReviewLog{
+		UserId:           card.CreatedBy,
+		CardId:           card.CardId,
+		DeckId:           card.DeckID,
+		Grade:            grade,
+		ReviewedAt:       now,
+		Interval:         card.Interval,
+		Easiness:         card.Easiness,
+		RepetitionNumber: card.RepetitionNumber,
+		ExpiresAt:        card.ExpiresAt,
+		Stability:        card.Stability,
+		Difficulty:       card.Difficulty,
+		Lapses:           card.Lapses,
+		LastReviewedAt:   card.LastReviewedAt,
+		Algorithm:        card.Algorithm,
+		Phase:            card.Phase,
+		Step:             card.Step,
+	}
+}
+
+func restoreCard(card *model.Card, reviewLog *model.ReviewLog) {
+	card.Interval = reviewLog.Interval
+	card.Easiness = reviewLog.Easiness
+	card.RepetitionNumber = reviewLog.RepetitionNumber
+	card.ExpiresAt = reviewLog.ExpiresAt
+	card.Stability = reviewLog.Stability
+	card.Difficulty = reviewLog.Difficulty
+	card.Lapses = reviewLog.Lapses
+	card.LastReviewedAt = reviewLog.LastReviewedAt
+	card.Algorithm = reviewLog.Algorithm
+	card.Phase = reviewLog.Phase
+	card.Step = reviewLog.Step
+}
+
+func applyState(card *model.Card, state scheduler.State) {
+	card.Phase = string(state.Phase)
+	card.Step = state.Step
+	card.Interval = state.Interval
+	card.Easiness = state.Easiness
+	card.RepetitionNumber = state.Repetitions
+	card.Stability = state.Stability
+	card.Difficulty = state.Difficulty
+	card.Lapses = state.Lapses
+	card.LastReviewedAt = state.LastReview
 }
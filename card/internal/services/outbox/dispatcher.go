@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GOeda-Co/proto-contract/model/card"
+)
+
+const maxBackoff = 10 * time.Minute
+
+type Repository interface {
+	ReadPendingOutboxEvents(now time.Time, maxAttempts, limit int) ([]model.OutboxEvent, error)
+	UpdateOutboxEvent(event *model.OutboxEvent) error
+}
+
+type StatsClient interface {
+	AddRecord(ctx context.Context, token, reviewId, deckId, cardId string, createdAt time.Time, grade int) (string, error)
+	DeleteRecord(ctx context.Context, token, reviewId string) error
+}
+
+type TokenIssuer interface {
+	IssueToken(userId uuid.UUID) (string, error)
+}
+
+type Config struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+}
+
+// Dispatcher delivers review events written to the outbox to stats service.
+// Failed events are retried with exponential backoff until MaxAttempts
+type Dispatcher struct {
+	log    *slog.Logger
+	repo   Repository
+	stats  StatsClient
+	tokens TokenIssuer
+	cfg    Config
+}
+
+func New(log *slog.Logger, repo Repository, stats StatsClient, tokens TokenIssuer, cfg Config) *Dispatcher {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+
+	return &Dispatcher{
+		log:    log,
+		repo:   repo,
+		stats:  stats,
+		tokens: tokens,
+		cfg:    cfg,
+	}
+}
+
+// Run dispatches pending events every interval until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DispatchPending(ctx, time.Now()); err != nil {
+			d.log.Error("Failed to dispatch outbox events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending delivers one batch of events due at now in the order they occurred
+// and returns how many were delivered. Events of a user wait while an earlier
+// event of the same user is not delivered, so a review is never removed before it is added
+func (d *Dispatcher) DispatchPending(ctx context.Context, now time.Time) (int, error) {
+	events, err := d.repo.ReadPendingOutboxEvents(now, d.cfg.MaxAttempts, d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	blocked := make(map[uuid.UUID]bool)
+	for i := range events {
+		event := &events[i]
+		if blocked[event.UserId] {
+			continue
+		}
+
+		if err := d.deliver(ctx, event); err != nil {
+			blocked[event.UserId] = true
+			event.Attempts++
+			event.NextAttemptAt = now.Add(backoff(event.Attempts))
+			event.LastError = err.Error()
+			d.log.Warn("Failed to deliver outbox event", "eventId", event.EventId, "attempts", event.Attempts, "error", err)
+		} else {
+			event.DeliveredAt = &now
+			event.LastError = ""
+			delivered++
+		}
+
+		if err := d.repo.UpdateOutboxEvent(event); err != nil {
+			return delivered, err
+		}
+	}
+	return delivered, nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, event *model.OutboxEvent) error {
+	token, err := d.tokens.IssueToken(event.UserId)
+	if err != nil {
+		return err
+	}
+
+	switch event.Kind {
+	case model.OutboxReviewAdded:
+		_, err = d.stats.AddRecord(ctx, token, event.ReviewId.String(), event.DeckId.String(), event.CardId.String(), event.OccurredAt, event.Grade)
+		return err
+	case model.OutboxReviewDeleted:
+		err = d.stats.DeleteRecord(ctx, token, event.ReviewId.String())
+		// Review is already gone, e.g. the event was delivered before but not marked
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown outbox event kind %q", event.Kind)
+	}
+}
+
+func backoff(attempts int) time.Duration {
+	delay := time.Second << min(attempts, 10)
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- FSRS memory state and the algorithm that produced the current schedule
+ALTER TABLE cards
+    ALTER COLUMN interval TYPE INTEGER,
+    ADD COLUMN IF NOT EXISTS stability DOUBLE PRECISION DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS difficulty DOUBLE PRECISION DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS lapses SMALLINT DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_reviewed_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS algorithm VARCHAR(16) DEFAULT 'sm2' NOT NULL;
+
+-- Per-user scheduling settings
+CREATE TABLE IF NOT EXISTS preferences (
+    user_id UUID PRIMARY KEY,
+    algorithm VARCHAR(16) DEFAULT 'sm2' NOT NULL,
+    updated_at TIMESTAMP
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS preferences;
+
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS algorithm,
+    DROP COLUMN IF EXISTS last_reviewed_at,
+    DROP COLUMN IF EXISTS lapses,
+    DROP COLUMN IF EXISTS difficulty,
+    DROP COLUMN IF EXISTS stability,
+    ALTER COLUMN interval TYPE SMALLINT;
+
+-- +goose StatementEnd
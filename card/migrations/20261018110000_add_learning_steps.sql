@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Learning phase of the card and its current (re)learning step
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS phase VARCHAR(16) DEFAULT 'new' NOT NULL,
+    ADD COLUMN IF NOT EXISTS step SMALLINT DEFAULT 0;
+
+-- Intervals used to be stored in minutes, cards reviewed at least a day apart become review cards
+UPDATE cards SET phase = CASE
+    WHEN interval >= 1440 THEN 'review'
+    WHEN repetition_number = 0 AND interval = 0 THEN 'new'
+    ELSE 'learning'
+END;
+
+UPDATE cards SET interval = interval / 1440;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+UPDATE cards SET interval = interval * 1440;
+
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS step,
+    DROP COLUMN IF EXISTS phase;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Cards studied per user, deck and day, used to enforce daily limits of decks
+CREATE TABLE IF NOT EXISTS daily_progress (
+    user_id UUID NOT NULL,
+    deck_id UUID NOT NULL,
+    day DATE NOT NULL,
+    new_introduced INTEGER DEFAULT 0 NOT NULL,
+    reviews_done INTEGER DEFAULT 0 NOT NULL,
+    PRIMARY KEY (user_id, deck_id, day)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS daily_progress;
+
+-- +goose StatementEnd
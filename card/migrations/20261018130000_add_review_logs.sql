@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Scheduling state of the card before every answer, used to undo answers
+CREATE TABLE IF NOT EXISTS review_logs (
+    review_log_id UUID PRIMARY KEY,
+    user_id UUID,
+    card_id UUID,
+    deck_id UUID,
+    review_id VARCHAR(36),
+    grade SMALLINT,
+    reviewed_at TIMESTAMP,
+    interval INTEGER,
+    easiness DOUBLE PRECISION,
+    repetition_number INTEGER,
+    expires_at TIMESTAMP,
+    stability DOUBLE PRECISION,
+    difficulty DOUBLE PRECISION,
+    lapses INTEGER,
+    last_reviewed_at TIMESTAMP,
+    algorithm VARCHAR(16),
+    phase VARCHAR(16),
+    step INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_logs_user_id ON review_logs(user_id);
+CREATE INDEX IF NOT EXISTS idx_review_logs_reviewed_at ON review_logs(reviewed_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS review_logs;
+
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Review events waiting to be delivered to stats service
+CREATE TABLE IF NOT EXISTS outbox (
+    event_id UUID PRIMARY KEY,
+    kind VARCHAR(32) NOT NULL,
+    user_id UUID,
+    deck_id UUID,
+    card_id UUID,
+    review_id UUID,
+    grade SMALLINT,
+    occurred_at TIMESTAMP,
+    attempts INTEGER DEFAULT 0 NOT NULL,
+    next_attempt_at TIMESTAMP,
+    delivered_at TIMESTAMP,
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_occurred_at ON outbox(occurred_at);
+CREATE INDEX IF NOT EXISTS idx_outbox_delivered_at ON outbox(delivered_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS outbox;
+
+-- +goose StatementEnd
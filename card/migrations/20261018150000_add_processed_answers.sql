@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Answers submitted with a client request ID, used to detect retried submissions
+CREATE TABLE IF NOT EXISTS processed_answers (
+    user_id UUID NOT NULL,
+    request_id VARCHAR(128) NOT NULL,
+    card_id UUID,
+    review_id VARCHAR(64),
+    next_review_at TIMESTAMP,
+    created_at TIMESTAMP,
+    PRIMARY KEY (user_id, request_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_processed_answers_created_at ON processed_answers(created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS processed_answers;
+
+-- +goose StatementEnd
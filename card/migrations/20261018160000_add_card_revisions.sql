@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Edit history of cards, every revision keeps the diff and content after the change
+CREATE TABLE IF NOT EXISTS card_revisions (
+    revision_id UUID PRIMARY KEY,
+    card_id UUID NOT NULL,
+    revision INTEGER NOT NULL,
+    edited_by UUID,
+    edited_at TIMESTAMP,
+    changes JSONB,
+    reverted_from INTEGER DEFAULT 0,
+    word VARCHAR(100),
+    translation VARCHAR(100),
+    tags TEXT[],
+    is_public BOOLEAN
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_card_revisions_card_revision ON card_revisions(card_id, revision);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS card_revisions;
+
+-- +goose StatementEnd
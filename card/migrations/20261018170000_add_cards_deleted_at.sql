@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Deleted cards stay in trash until purged
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_cards_deleted_at ON cards(deleted_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_cards_deleted_at;
+ALTER TABLE cards DROP COLUMN IF EXISTS deleted_at;
+
+-- +goose StatementEnd
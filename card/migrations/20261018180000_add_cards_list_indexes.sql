@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Keyset pagination of card lists, newest first
+CREATE INDEX IF NOT EXISTS idx_cards_created_by_created_at ON cards(created_by, created_at DESC, card_id DESC);
+CREATE INDEX IF NOT EXISTS idx_cards_deck_id_created_at ON cards(deck_id, created_at DESC, card_id DESC);
+CREATE INDEX IF NOT EXISTS idx_cards_public_created_at ON cards(created_at DESC, card_id DESC) WHERE is_public;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_cards_public_created_at;
+DROP INDEX IF EXISTS idx_cards_deck_id_created_at;
+DROP INDEX IF EXISTS idx_cards_created_by_created_at;
+
+-- +goose StatementEnd
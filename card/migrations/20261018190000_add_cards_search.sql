@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Full-text search document of a card. Word weighs more than translation and
+-- tags. Text is indexed both stemmed (english) and as is (simple), so inflected
+-- english forms and words of other languages are found
+CREATE OR REPLACE FUNCTION cards_search_vector(word TEXT, translation TEXT, tags TEXT[])
+RETURNS tsvector
+LANGUAGE sql IMMUTABLE
+AS $$
+    SELECT setweight(to_tsvector('english', coalesce(word, '')) || to_tsvector('simple', coalesce(word, '')), 'A')
+        || setweight(to_tsvector('english', coalesce(translation, '')) || to_tsvector('simple', coalesce(translation, '')), 'B')
+        || setweight(to_tsvector('english', coalesce(array_to_string(tags, ' '), '')) || to_tsvector('simple', coalesce(array_to_string(tags, ' '), '')), 'C')
+$$;
+
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (cards_search_vector(word, translation, tags)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_cards_search_vector ON cards USING GIN (search_vector);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_cards_search_vector;
+ALTER TABLE cards DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS cards_search_vector(TEXT, TEXT, TEXT[]);
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Card lists and the study queue filter by tag with tags @> ARRAY[tag]
+CREATE INDEX IF NOT EXISTS idx_cards_tags ON cards USING GIN (tags);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_cards_tags;
+
+-- +goose StatementEnd
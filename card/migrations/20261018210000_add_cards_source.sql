@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Cloned cards refer back to the card they were copied from
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS source_card_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_cards_source_card_id ON cards(source_card_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_cards_source_card_id;
+ALTER TABLE cards DROP COLUMN IF EXISTS source_card_id;
+
+-- +goose StatementEnd
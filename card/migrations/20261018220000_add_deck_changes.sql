@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Changelog of shared decks, one row per card changed in a published version
+CREATE TABLE IF NOT EXISTS deck_changes (
+    deck_change_id UUID PRIMARY KEY,
+    deck_id UUID,
+    version INTEGER NOT NULL,
+    card_id UUID,
+    action VARCHAR(16) NOT NULL,
+    word VARCHAR(100),
+    translation VARCHAR(100),
+    tags TEXT[],
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_deck_changes_deck_version ON deck_changes(deck_id, version);
+
+-- Cloned cards edited by the user don't take changes of their source card
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS keep_local BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE cards DROP COLUMN IF EXISTS keep_local;
+DROP TABLE IF EXISTS deck_changes;
+
+-- +goose StatementEnd
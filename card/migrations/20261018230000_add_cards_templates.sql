@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Cards ask the word or the translation. Siblings added together share a note
+-- and can be buried for the day once one of them was reviewed
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS template VARCHAR(16) DEFAULT 'forward' NOT NULL,
+    ADD COLUMN IF NOT EXISTS note_id UUID,
+    ADD COLUMN IF NOT EXISTS buried_until TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_cards_note_id ON cards(note_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_cards_note_id;
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS template,
+    DROP COLUMN IF EXISTS note_id,
+    DROP COLUMN IF EXISTS buried_until;
+
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Cloze cards ask one cloze index of their note each, the translation is only
+-- extra text for them and may be empty
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS ordinal SMALLINT DEFAULT 0 NOT NULL,
+    ALTER COLUMN translation SET DEFAULT '';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS ordinal,
+    ALTER COLUMN translation DROP DEFAULT;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Changes carry template, note and cloze index of the card, so subscribers add
+-- reverse and cloze cards as siblings of one note
+ALTER TABLE deck_changes
+    ADD COLUMN IF NOT EXISTS template VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS note_id UUID,
+    ADD COLUMN IF NOT EXISTS ordinal SMALLINT DEFAULT 0 NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE deck_changes
+    DROP COLUMN IF EXISTS template,
+    DROP COLUMN IF EXISTS note_id,
+    DROP COLUMN IF EXISTS ordinal;
+
+-- +goose StatementEnd
@@ -0,0 +1,143 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GOeda-Co/proto-contract/model/card"
+	"github.com/tomatoCoderq/card/internal/services/outbox"
+)
+
+type MockOutboxRepo struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepo) ReadPendingOutboxEvents(now time.Time, maxAttempts, limit int) ([]model.OutboxEvent, error) {
+	args := m.Called(now, maxAttempts, limit)
+	return args.Get(0).([]model.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepo) UpdateOutboxEvent(event *model.OutboxEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+type MockStatsClient struct {
+	mock.Mock
+}
+
+func (m *MockStatsClient) AddRecord(ctx context.Context, token, reviewId, deckId, cardId string, createdAt time.Time, grade int) (string, error) {
+	args := m.Called(token, reviewId, deckId, cardId, grade)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockStatsClient) DeleteRecord(ctx context.Context, token, reviewId string) error {
+	args := m.Called(token, reviewId)
+	return args.Error(0)
+}
+
+type stubTokens struct{}
+
+func (stubTokens) IssueToken(userId uuid.UUID) (string, error) {
+	return "token-" + userId.String(), nil
+}
+
+func newEvent(kind string, userId uuid.UUID, occurredAt time.Time) model.OutboxEvent {
+	return model.OutboxEvent{
+		EventId:       uuid.New(),
+		Kind:          kind,
+		UserId:        userId,
+		DeckId:        uuid.New(),
+		CardId:        uuid.New(),
+		ReviewId:      uuid.New(),
+		Grade:         4,
+		OccurredAt:    occurredAt,
+		NextAttemptAt: occurredAt,
+	}
+}
+
+func TestDispatchPending_DeliversWithIdempotencyKey(t *testing.T) {
+	repo := new(MockOutboxRepo)
+	stats := new(MockStatsClient)
+	dispatcher := outbox.New(slog.Default(), repo, stats, stubTokens{}, outbox.Config{})
+
+	now := time.Now()
+	userId := uuid.New()
+	added := newEvent(model.OutboxReviewAdded, userId, now.Add(-time.Minute))
+	deleted := newEvent(model.OutboxReviewDeleted, userId, now.Add(-time.Second))
+
+	repo.On("ReadPendingOutboxEvents", now, 10, 100).Return([]model.OutboxEvent{added, deleted}, nil)
+	stats.On("AddRecord", "token-"+userId.String(), added.ReviewId.String(), added.DeckId.String(), added.CardId.String(), 4).Return(added.ReviewId.String(), nil)
+	// Already removed in stats, counts as delivered
+	stats.On("DeleteRecord", "token-"+userId.String(), deleted.ReviewId.String()).Return(status.Error(codes.NotFound, "review not found"))
+	repo.On("UpdateOutboxEvent", mock.MatchedBy(func(e *model.OutboxEvent) bool {
+		return e.DeliveredAt != nil && e.Attempts == 0
+	})).Return(nil).Twice()
+
+	delivered, err := dispatcher.DispatchPending(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, delivered)
+	repo.AssertExpectations(t)
+	stats.AssertExpectations(t)
+}
+
+func TestDispatchPending_FailureBacksOffAndBlocksUser(t *testing.T) {
+	repo := new(MockOutboxRepo)
+	stats := new(MockStatsClient)
+	dispatcher := outbox.New(slog.Default(), repo, stats, stubTokens{}, outbox.Config{})
+
+	now := time.Now()
+	userId := uuid.New()
+	otherUserId := uuid.New()
+	failed := newEvent(model.OutboxReviewAdded, userId, now.Add(-time.Minute))
+	failed.Attempts = 2
+	waiting := newEvent(model.OutboxReviewDeleted, userId, now.Add(-time.Second))
+	other := newEvent(model.OutboxReviewAdded, otherUserId, now.Add(-time.Second))
+
+	repo.On("ReadPendingOutboxEvents", now, 10, 100).Return([]model.OutboxEvent{failed, waiting, other}, nil)
+	stats.On("AddRecord", "token-"+userId.String(), failed.ReviewId.String(), mock.Anything, mock.Anything, 4).Return("", errors.New("unavailable"))
+	stats.On("AddRecord", "token-"+otherUserId.String(), other.ReviewId.String(), mock.Anything, mock.Anything, 4).Return(other.ReviewId.String(), nil)
+	repo.On("UpdateOutboxEvent", mock.MatchedBy(func(e *model.OutboxEvent) bool {
+		return e.EventId == failed.EventId && e.Attempts == 3 && e.DeliveredAt == nil &&
+			e.NextAttemptAt.Equal(now.Add(8*time.Second)) && e.LastError == "unavailable"
+	})).Return(nil).Once()
+	repo.On("UpdateOutboxEvent", mock.MatchedBy(func(e *model.OutboxEvent) bool {
+		return e.EventId == other.EventId && e.DeliveredAt != nil
+	})).Return(nil).Once()
+
+	delivered, err := dispatcher.DispatchPending(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	repo.AssertExpectations(t)
+	stats.AssertExpectations(t)
+	stats.AssertNotCalled(t, "DeleteRecord", mock.Anything, waiting.ReviewId.String())
+}
+
+func TestDispatchPending_ReadsEventsDueNow(t *testing.T) {
+	repo := new(MockOutboxRepo)
+	stats := new(MockStatsClient)
+	dispatcher := outbox.New(slog.Default(), repo, stats, stubTokens{}, outbox.Config{BatchSize: 10, MaxAttempts: 5})
+
+	now := time.Now()
+
+	// Events waiting for a retry are left out by the repository
+	repo.On("ReadPendingOutboxEvents", now, 5, 10).Return([]model.OutboxEvent{}, nil)
+
+	delivered, err := dispatcher.DispatchPending(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	stats.AssertNotCalled(t, "AddRecord")
+	repo.AssertNotCalled(t, "UpdateOutboxEvent", mock.Anything)
+}
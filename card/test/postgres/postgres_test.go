@@ -13,7 +13,9 @@ import (
 	"github.com/tomatoCoderq/card/internal/config"
 
 	"github.com/GOeda-Co/proto-contract/model/card"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	"github.com/tomatoCoderq/card/internal/repository/postgresql"
+	services "github.com/tomatoCoderq/card/internal/services/card"
 )
 
 var repo *postgresql.Repository
@@ -76,7 +78,7 @@ func TestCardRepo_CRUD(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Front side", readCard.Word)
 
-	cards, err := repo.ReadAllOwnCardsToLearn(userId)
+	cards, err := repo.ReadAllOwnCardsToLearn(userId, uuid.Nil, "")
 	assert.NoError(t, err)
 	fmt.Println(len(cards) >= 1)
 	assert.True(t, len(cards) >= 1)
@@ -95,13 +97,90 @@ func TestCardRepo_CRUD(t *testing.T) {
 	assert.Equal(t, &model.Card{}, deleted)
 }
 
+func TestPureUpdate_KeepsUpdatedAt(t *testing.T) {
+	noteId := uuid.New()
+	editedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	card := &model.Card{CardId: uuid.New(), CreatedBy: uuid.New(), Word: "w", Translation: "t", NoteId: &noteId, ExpiresAt: time.Now()}
+	sibling := &model.Card{CardId: uuid.New(), CreatedBy: card.CreatedBy, Word: "t", Translation: "w", NoteId: &noteId, ExpiresAt: time.Now()}
+	assert.NoError(t, repo.AddCard(card))
+	assert.NoError(t, repo.AddCard(sibling))
+	defer repo.DeleteCard(card.CardId)
+	defer repo.DeleteCard(sibling.CardId)
+
+	card.UpdatedAt = editedAt
+	assert.NoError(t, repo.PureUpdate(card))
+	sibling.UpdatedAt = editedAt
+	assert.NoError(t, repo.PureUpdate(sibling))
+
+	// A review writes scheduling and buries the sibling, neither is an edit
+	card.Phase = "learning"
+	card.Interval = 1
+	assert.NoError(t, repo.PureUpdate(card))
+	until := time.Now().Add(time.Hour)
+	assert.NoError(t, repo.BurySiblings(noteId, card.CardId, &until))
+
+	for _, id := range []uuid.UUID{card.CardId, sibling.CardId} {
+		read, err := repo.ReadCard(id)
+		assert.NoError(t, err)
+		assert.True(t, read.UpdatedAt.Equal(editedAt), "updated_at of %s moved to %s", id, read.UpdatedAt)
+	}
+}
+
+func TestReadDeckCards_KeepsTemplates(t *testing.T) {
+	deckId := uuid.New()
+	noteId := uuid.New()
+	forward := &model.Card{CardId: uuid.New(), CreatedBy: uuid.New(), DeckID: deckId, Word: "gato", Translation: "cat", Template: model.TemplateForward, NoteId: &noteId, ExpiresAt: time.Now()}
+	reverse := &model.Card{CardId: uuid.New(), CreatedBy: forward.CreatedBy, DeckID: deckId, Word: "gato", Translation: "cat", Template: model.TemplateReverse, NoteId: &noteId, ExpiresAt: time.Now()}
+	assert.NoError(t, repo.AddCard(forward))
+	assert.NoError(t, repo.AddCard(reverse))
+	defer repo.DeleteCard(forward.CardId)
+	defer repo.DeleteCard(reverse.CardId)
+
+	// Cloning and publishing copy these, not only the content
+	cards, err := repo.ReadDeckCards(deckId)
+	assert.NoError(t, err)
+	templates := make(map[uuid.UUID]string)
+	for _, card := range cards {
+		templates[card.CardId] = card.Template
+		if assert.NotNil(t, card.NoteId) {
+			assert.Equal(t, noteId, *card.NoteId)
+		}
+	}
+	assert.Equal(t, map[uuid.UUID]string{forward.CardId: model.TemplateForward, reverse.CardId: model.TemplateReverse}, templates)
+}
+
+func TestReadDeckCards_KeepsClozeNote(t *testing.T) {
+	deckId := uuid.New()
+	noteId := uuid.New()
+	word := "The {{c1::cat}} sat on the {{c2::mat}}"
+	var added []uuid.UUID
+	for _, ordinal := range []int{1, 2} {
+		card := &model.Card{CardId: uuid.New(), CreatedBy: uuid.New(), DeckID: deckId, Word: word, Template: model.TemplateCloze, NoteId: &noteId, Ordinal: ordinal, ExpiresAt: time.Now()}
+		assert.NoError(t, repo.AddCard(card))
+		defer repo.DeleteCard(card.CardId)
+		added = append(added, card.CardId)
+	}
+
+	cards, err := repo.ReadDeckCards(deckId)
+	assert.NoError(t, err)
+	ordinals := make(map[uuid.UUID]int)
+	for _, card := range cards {
+		assert.Equal(t, model.TemplateCloze, card.Template)
+		if assert.NotNil(t, card.NoteId) {
+			assert.Equal(t, noteId, *card.NoteId)
+		}
+		ordinals[card.CardId] = card.Ordinal
+	}
+	assert.Equal(t, map[uuid.UUID]int{added[0]: 1, added[1]: 2}, ordinals)
+}
+
 func TestSearchAllPublicCards(t *testing.T) {
 	/*
 		Search all public cards available in the repository
 		Here I assume that there at least one public card exists
 		so the result should not be empty
 	*/
-	results, err := repo.SearchAllPublicCards() // Search all cards that are public
+	results, err := repo.SearchAllPublicCards("", pagination.Page{Limit: pagination.DefaultLimit}) // Search all cards that are public
 	assert.NoError(t, err)
 	assert.NotEmpty(t, results)
 }
@@ -113,7 +192,95 @@ func TestSearchUserPublicCards(t *testing.T) {
 		Here I use a user ID that is expected to not have any public cards
 		so the result should be empty
 	*/
-	results, err := repo.SearchUserPublicCards(userId)
+	results, err := repo.SearchUserPublicCards(userId, "", pagination.Page{Limit: pagination.DefaultLimit})
 	assert.NoError(t, err)
 	assert.Empty(t, results)
 }
+
+func TestSearchPublicCards_FullText(t *testing.T) {
+	userId := uuid.New()
+	card := &model.Card{
+		CardId:      uuid.New(),
+		CreatedBy:   userId,
+		Word:        "running",
+		Translation: "бег",
+		Tags:        []string{"sport"},
+		IsPublic:    true,
+		ExpiresAt:   time.Now(),
+	}
+	assert.NoError(t, repo.AddCard(card))
+	defer repo.DeleteCard(card.CardId)
+
+	// Stemmed english word, exact word of another language and a tag
+	for _, query := range []string{"runs", "бег", "sport"} {
+		results, err := repo.SearchUserPublicCards(userId, query, pagination.Page{Limit: pagination.DefaultLimit})
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1, query) {
+			assert.Greater(t, results[0].Rank, float32(0))
+		}
+	}
+
+	results, err := repo.SearchUserPublicCards(userId, "running", pagination.Page{Limit: pagination.DefaultLimit})
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "<b>running</b>", results[0].WordHighlight)
+	}
+
+	results, err = repo.SearchUserPublicCards(userId, "swimming", pagination.Page{Limit: pagination.DefaultLimit})
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestReadPendingOutboxEvents_LeavesOutBlockedUsers(t *testing.T) {
+	now := time.Now().Truncate(time.Microsecond)
+	blockedUser, freeUser := uuid.New(), uuid.New()
+	retrying := &model.OutboxEvent{Kind: model.OutboxReviewAdded, UserId: blockedUser, OccurredAt: now.Add(-2 * time.Minute), Attempts: 1, NextAttemptAt: now.Add(time.Minute)}
+	waiting := &model.OutboxEvent{Kind: model.OutboxReviewDeleted, UserId: blockedUser, OccurredAt: now.Add(-time.Minute), NextAttemptAt: now.Add(-time.Minute)}
+	due := &model.OutboxEvent{Kind: model.OutboxReviewAdded, UserId: freeUser, OccurredAt: now.Add(-time.Minute), NextAttemptAt: now.Add(-time.Minute)}
+	for _, event := range []*model.OutboxEvent{retrying, waiting, due} {
+		assert.NoError(t, repo.AddOutboxEvent(event))
+		defer func(event *model.OutboxEvent) {
+			event.DeliveredAt = &now
+			repo.UpdateOutboxEvent(event)
+		}(event)
+	}
+
+	events, err := repo.ReadPendingOutboxEvents(now, 10, 1000)
+	assert.NoError(t, err)
+
+	var ids []uuid.UUID
+	for _, event := range events {
+		if event.UserId == blockedUser || event.UserId == freeUser {
+			ids = append(ids, event.EventId)
+		}
+	}
+	assert.Equal(t, []uuid.UUID{due.EventId}, ids)
+}
+
+func TestAddProcessedAnswer_KeepsStoredResult(t *testing.T) {
+	stored := &model.ProcessedAnswer{UserId: uuid.New(), RequestId: "req-1", CardId: uuid.New(), ReviewId: "first", CreatedAt: time.Now()}
+	assert.NoError(t, repo.AddProcessedAnswer(stored))
+
+	again := &model.ProcessedAnswer{UserId: stored.UserId, RequestId: stored.RequestId, CardId: stored.CardId, ReviewId: "second", CreatedAt: time.Now()}
+	assert.ErrorIs(t, repo.AddProcessedAnswer(again), services.ErrAnswerProcessed)
+
+	read, err := repo.ReadProcessedAnswer(stored.UserId, stored.RequestId)
+	assert.NoError(t, err)
+	assert.Equal(t, "first", read.ReviewId)
+}
+
+func TestDetachDeckCards_MakesCardsPrivate(t *testing.T) {
+	deckId := uuid.New()
+	card := &model.Card{CardId: uuid.New(), CreatedBy: uuid.New(), DeckID: deckId, Word: "w", Translation: "t", IsPublic: true, ExpiresAt: time.Now()}
+	assert.NoError(t, repo.AddCard(card))
+	defer repo.DeleteCard(card.CardId)
+
+	detached, err := repo.DetachDeckCards(deckId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), detached)
+
+	read, err := repo.ReadCard(card.CardId)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.Nil, read.DeckID)
+	assert.False(t, read.IsPublic)
+}
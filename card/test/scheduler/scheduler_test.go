@@ -0,0 +1,148 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomatoCoderq/card/internal/lib/scheduler"
+)
+
+func TestParse(t *testing.T) {
+	algorithm, err := scheduler.Parse("")
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.SM2, algorithm)
+
+	algorithm, err = scheduler.Parse("fsrs")
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.FSRS, algorithm)
+
+	_, err = scheduler.Parse("leitner")
+	assert.ErrorIs(t, err, scheduler.ErrUnknownAlgorithm)
+}
+
+func TestLearningSteps(t *testing.T) {
+	s, err := scheduler.New(scheduler.SM2, scheduler.DefaultConfig())
+	assert.NoError(t, err)
+
+	now := time.Now()
+	first := s.Schedule(now, scheduler.State{Phase: scheduler.PhaseNew, Easiness: 2.5}, 4)
+	assert.Equal(t, scheduler.PhaseLearning, first.Phase)
+	assert.Equal(t, 1, first.Step)
+	assert.Equal(t, now.Add(10*time.Minute), first.NextReviewTime)
+
+	again := s.Schedule(now, first.State, 1)
+	assert.Equal(t, 0, again.Step)
+	assert.Equal(t, now.Add(time.Minute), again.NextReviewTime)
+
+	graduated := s.Schedule(now, first.State, 4)
+	assert.Equal(t, scheduler.PhaseReview, graduated.Phase)
+	assert.Equal(t, 1, graduated.Interval)
+	assert.Equal(t, 1, graduated.Repetitions)
+	assert.Equal(t, now.AddDate(0, 0, 1), graduated.NextReviewTime)
+	assert.Equal(t, 2.5, graduated.Easiness)
+}
+
+func TestLearningSteps_EasyGraduatesImmediately(t *testing.T) {
+	s, _ := scheduler.New(scheduler.SM2, scheduler.DefaultConfig())
+
+	now := time.Now()
+	result := s.Schedule(now, scheduler.State{Phase: scheduler.PhaseNew, Easiness: 2.5}, 5)
+
+	assert.Equal(t, scheduler.PhaseReview, result.Phase)
+	assert.Equal(t, 4, result.Interval)
+	assert.Equal(t, now.AddDate(0, 0, 4), result.NextReviewTime)
+}
+
+func TestSM2_ReviewIntervalInDays(t *testing.T) {
+	s, _ := scheduler.New(scheduler.SM2, scheduler.DefaultConfig())
+
+	now := time.Now()
+	state := scheduler.State{Phase: scheduler.PhaseReview, Interval: 6, Easiness: 2.5, Repetitions: 2}
+	result := s.Schedule(now, state, 4)
+
+	assert.Equal(t, scheduler.PhaseReview, result.Phase)
+	assert.Equal(t, 15, result.Interval)
+	assert.Equal(t, now.AddDate(0, 0, 15), result.NextReviewTime)
+}
+
+func TestSM2_LapseGoesToRelearning(t *testing.T) {
+	s, _ := scheduler.New(scheduler.SM2, scheduler.DefaultConfig())
+
+	now := time.Now()
+	state := scheduler.State{Phase: scheduler.PhaseReview, Interval: 15, Easiness: 2.5, Repetitions: 3}
+	lapse := s.Schedule(now, state, 1)
+
+	assert.Equal(t, scheduler.PhaseRelearning, lapse.Phase)
+	assert.Equal(t, 1, lapse.Lapses)
+	assert.Equal(t, now.Add(10*time.Minute), lapse.NextReviewTime)
+
+	relearned := s.Schedule(now, lapse.State, 4)
+	assert.Equal(t, scheduler.PhaseReview, relearned.Phase)
+	assert.Equal(t, 1, relearned.Interval)
+}
+
+func TestMaxInterval(t *testing.T) {
+	cfg := scheduler.DefaultConfig()
+	cfg.MaxInterval = 10
+	s, _ := scheduler.New(scheduler.SM2, cfg)
+
+	state := scheduler.State{Phase: scheduler.PhaseReview, Interval: 8, Easiness: 2.5, Repetitions: 2}
+	result := s.Schedule(time.Now(), state, 5)
+
+	assert.Equal(t, 10, result.Interval)
+}
+
+func TestFSRS_FirstReview(t *testing.T) {
+	s, err := scheduler.New(scheduler.FSRS, scheduler.DefaultConfig())
+	assert.NoError(t, err)
+
+	now := time.Now()
+	good := s.Schedule(now, scheduler.State{}, 4)
+	easy := s.Schedule(now, scheduler.State{}, 5)
+
+	assert.Equal(t, 1, good.Repetitions)
+	assert.Equal(t, scheduler.PhaseLearning, good.Phase)
+	assert.Equal(t, scheduler.PhaseReview, easy.Phase)
+	assert.Greater(t, easy.Stability, good.Stability)
+	assert.Less(t, easy.Difficulty, good.Difficulty)
+	assert.True(t, easy.NextReviewTime.After(good.NextReviewTime))
+	assert.Equal(t, now, *good.LastReview)
+}
+
+func TestFSRS_StabilityGrowsOnRecall(t *testing.T) {
+	s, _ := scheduler.New(scheduler.FSRS, scheduler.DefaultConfig())
+
+	now := time.Now()
+	first := s.Schedule(now, scheduler.State{}, 5)
+	second := s.Schedule(first.NextReviewTime, first.State, 4)
+
+	assert.Greater(t, second.Stability, first.Stability)
+	assert.Greater(t, second.Interval, first.Interval)
+}
+
+func TestFSRS_LapseShrinksStability(t *testing.T) {
+	s, _ := scheduler.New(scheduler.FSRS, scheduler.DefaultConfig())
+
+	now := time.Now()
+	first := s.Schedule(now, scheduler.State{}, 5)
+	lapse := s.Schedule(first.NextReviewTime, first.State, 1)
+
+	assert.Less(t, lapse.Stability, first.Stability)
+	assert.Greater(t, lapse.Difficulty, first.Difficulty)
+	assert.Equal(t, 1, lapse.Lapses)
+	assert.Equal(t, 0, lapse.Repetitions)
+	assert.Equal(t, scheduler.PhaseRelearning, lapse.Phase)
+}
+
+func TestSM2_KeepsFSRSState(t *testing.T) {
+	s, _ := scheduler.New(scheduler.SM2, scheduler.DefaultConfig())
+
+	state := scheduler.State{Phase: scheduler.PhaseReview, Easiness: 2.5, Stability: 3.2, Difficulty: 5}
+	result := s.Schedule(time.Now(), state, 4)
+
+	assert.Equal(t, 3.2, result.Stability)
+	assert.Equal(t, 5.0, result.Difficulty)
+	assert.Equal(t, 1, result.Repetitions)
+}
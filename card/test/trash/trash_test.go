@@ -0,0 +1,48 @@
+package trash_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tomatoCoderq/card/internal/services/trash"
+)
+
+type MockTrashRepo struct {
+	mock.Mock
+}
+
+func (m *MockTrashRepo) PurgeCards(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestPurge_UsesRetention(t *testing.T) {
+	repo := new(MockTrashRepo)
+	purger := trash.New(slog.Default(), repo, trash.Config{Retention: 48 * time.Hour})
+
+	now := time.Now()
+	repo.On("PurgeCards", now.Add(-48*time.Hour)).Return(int64(3), nil)
+
+	purged, err := purger.Purge(now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+	repo.AssertExpectations(t)
+}
+
+func TestPurge_DefaultRetention(t *testing.T) {
+	repo := new(MockTrashRepo)
+	purger := trash.New(slog.Default(), repo, trash.Config{})
+
+	now := time.Now()
+	repo.On("PurgeCards", now.Add(-30*24*time.Hour)).Return(int64(0), nil)
+
+	_, err := purger.Purge(now)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os/signal"
 	"syscall"
@@ -15,9 +16,11 @@ import (
 	// "net/http"
 
 	"github.com/joho/godotenv"
+	cardClient "github.com/tomatoCoderq/deck/internal/clients/card/grpc"
 	// client "github.com/tomatoCoderq/deck/internal/clients/sso/grpc"
 	"github.com/tomatoCoderq/deck/internal/config"
 	"github.com/tomatoCoderq/deck/internal/lib/security"
+	"github.com/tomatoCoderq/deck/internal/services/trash"
 	"gopkg.in/yaml.v3"
 
 	// userHttp "github.com/tomatoCoderq/card/internal/controller/http"
@@ -97,17 +100,31 @@ func main() {
 		ExpirationDelta: 600 * time.Minute,
 	}
 
-	application := app.New(log, cfg.GRPC.Port, cfg.ConnectionString, security)
+	trashConfig := trash.Config{
+		Retention:     cfg.Trash.Retention,
+		PurgeInterval: cfg.Trash.PurgeInterval,
+	}
+
+	cardClient, err := cardClient.New(context.Background(), log, cfg.Clients.Card.Address, cfg.Clients.Card.Timeout.Abs(), cfg.Clients.Card.RetriesCount)
+	if err != nil {
+		panic(err)
+	}
+
+	application := app.New(log, cfg.GRPC.Port, cfg.ConnectionString, cardClient, security, trashConfig)
 	go func() {
 		application.GRPCServer.MustRun()
 	}()
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	go application.Trash.Run(jobsCtx)
+
 	// TODO: Завершить работу программы
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
 	<-stop
 
+	stopJobs()
 	application.GRPCServer.Stop()
 	// TODO: Add close for db
 	log.Info("Gracefully stopped")
@@ -3,7 +3,7 @@ module github.com/tomatoCoderq/deck
 go 1.24.0
 
 require (
-	github.com/GOeda-Co/proto-contract v0.4.6
+	github.com/GOeda-Co/proto-contract v0.5.0
 	github.com/gin-gonic/gin v1.10.1
 	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/google/uuid v1.6.0
@@ -65,3 +65,5 @@ require (
 	google.golang.org/protobuf v1.36.6
 	gopkg.in/yaml.v3 v3.0.1
 )
+
+replace github.com/GOeda-Co/proto-contract => ../proto-contract
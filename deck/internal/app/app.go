@@ -4,28 +4,35 @@ import (
 	"log/slog"
 
 	"github.com/tomatoCoderq/deck/internal/app/grpc"
+	cardClient "github.com/tomatoCoderq/deck/internal/clients/card/grpc"
 	// client "github.com/tomatoCoderq/deck/internal/clients/sso/grpc"
 	"github.com/tomatoCoderq/deck/internal/lib/security"
 	"github.com/tomatoCoderq/deck/internal/repository/postgresql"
 	"github.com/tomatoCoderq/deck/internal/services/deck"
+	"github.com/tomatoCoderq/deck/internal/services/trash"
 )
 
 type App struct {
 	GRPCServer *grpcapp.App
+	Trash      *trash.Purger
 }
 
 func New(
 	log *slog.Logger,
 	grpcPort int,
 	storageAddress string,
+	cardClient *cardClient.Client,
 	security security.Security,
+	trashConfig trash.Config,
 ) *App {
 	storage := postgresql.New(storageAddress, log)
 
-	authService := services.New(log, storage)
+	authService := services.New(log, storage, cardClient)
 	grpcApp := grpcapp.New(log, authService, grpcPort, security)
+	purger := trash.New(log, storage, trashConfig)
 
 	return &App{
 		GRPCServer: grpcApp,
+		Trash:      purger,
 	}
 }
@@ -1 +1,108 @@
 package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	cardv1 "github.com/GOeda-Co/proto-contract/gen/go/card"
+	"github.com/google/uuid"
+	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Client struct {
+	api cardv1.CardServiceClient
+	log *slog.Logger
+}
+
+func New(
+	ctx context.Context,
+	log *slog.Logger,
+	addr string,
+	timeout time.Duration,
+	retriesCount int,
+) (*Client, error) {
+	const op = "grpc.New"
+
+	retryOpts := []grpcretry.CallOption{
+		grpcretry.WithCodes(codes.Aborted, codes.DeadlineExceeded),
+		grpcretry.WithMax(uint(retriesCount)),
+		grpcretry.WithPerRetryTimeout(timeout),
+	}
+
+	logOpts := []grpclog.Option{
+		grpclog.WithLogOnEvents(grpclog.PayloadReceived, grpclog.PayloadSent),
+	}
+
+	cc, err := grpc.DialContext(ctx, addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			grpclog.UnaryClientInterceptor(InterceptorLogger(log), logOpts...),
+			grpcretry.UnaryClientInterceptor(retryOpts...),
+		))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Client{
+		api: cardv1.NewCardServiceClient(cc),
+		log: log,
+	}, nil
+}
+
+// InterceptorLogger adapts slog logger to interceptor logger.
+func InterceptorLogger(l *slog.Logger) grpclog.Logger {
+	return grpclog.LoggerFunc(func(ctx context.Context, lvl grpclog.Level, msg string, fields ...any) {
+		l.Log(ctx, slog.Level(lvl), msg, fields...)
+	})
+}
+
+// forwardToken passes the caller's token on, so card service acts on behalf of the same user
+func forwardToken(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if auth := md.Get("authorization"); len(auth) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth[0])
+	}
+	return ctx
+}
+
+// ReleaseDeckCards asks card service to trash, move or detach cards of a deck being deleted
+func (c *Client) ReleaseDeckCards(ctx context.Context, deckId uuid.UUID, mode string, targetDeckId uuid.UUID, deletedAt time.Time) (int, error) {
+	const op = "grpc.ReleaseDeckCards"
+
+	req := &cardv1.ReleaseDeckCardsRequest{
+		DeckId:    deckId.String(),
+		Mode:      mode,
+		DeletedAt: timestamppb.New(deletedAt),
+	}
+	if targetDeckId != uuid.Nil {
+		req.TargetDeckId = targetDeckId.String()
+	}
+
+	resp, err := c.api.ReleaseDeckCards(forwardToken(ctx), req)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(resp.CardsCount), nil
+}
+
+// CloneDeckCards asks card service to copy cards of the source deck into the deck
+func (c *Client) CloneDeckCards(ctx context.Context, sourceDeckId uuid.UUID, deckId uuid.UUID) (int, error) {
+	const op = "grpc.CloneDeckCards"
+
+	resp, err := c.api.CloneDeckCards(forwardToken(ctx), &cardv1.CloneDeckCardsRequest{
+		SourceDeckId: sourceDeckId.String(),
+		DeckId:       deckId.String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(resp.CardsCount), nil
+}
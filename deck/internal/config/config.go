@@ -12,9 +12,16 @@ type Config struct {
 	Env              string `yaml:"env" env-default:"local"`
 	ConnectionString string `yaml:"connection_string" env-required:"true"`
 	// HTTPServer       `yaml:"http_server"`
-	Secret string `yaml:"secret" env-required:"true"`
-	// Clients          ClientsConfig `yaml:"clients"`
-	GRPC GRPCConfig `yaml:"grpc"`
+	Secret  string        `yaml:"secret" env-required:"true"`
+	Clients ClientsConfig `yaml:"clients"`
+	GRPC    GRPCConfig    `yaml:"grpc"`
+	Trash   TrashConfig   `yaml:"trash"`
+}
+
+// TrashConfig sets how long deleted decks are kept before they are purged
+type TrashConfig struct {
+	Retention     time.Duration `yaml:"retention"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
 }
 
 type GRPCConfig struct {
@@ -22,7 +29,6 @@ type GRPCConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
-/* FOR CLIENT CONFIGURATION (FUTURE USE)
 type Client struct {
 	Address      string        `yaml:"address" env-required:"true"`
 	Timeout      time.Duration `yaml:"timeout"`
@@ -30,9 +36,9 @@ type Client struct {
 }
 
 type ClientsConfig struct {
-	SSO Client `yaml:"sso"`
+	// SSO Client `yaml:"sso"`
+	Card Client `yaml:"card"`
 }
-*/
 
 func MustLoad() *Config {
 	configPath := os.Getenv("CONFIG_PATH")
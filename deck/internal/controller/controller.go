@@ -1,19 +1,39 @@
 package controller
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	// models "github.com/tomatoCoderq/deck/pkg/model"
 	modelCard "github.com/GOeda-Co/proto-contract/model/card"
 	"github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 )
 
 type Deck interface {
 	AddDeck(deck *model.Deck) (*model.Deck, error)
-	ReadAllDecksOfUser(userId uuid.UUID) ([]model.Deck, error)
-	ReadAllCardsFromDeck(deckId uuid.UUID, userId uuid.UUID) ([]modelCard.Card, error)
-	SearchAllPublicDecks() ([]model.Deck, error)
-	SearchUserPublicDecks(userId string) ([]model.Deck, error)
+	ReadAllDecksOfUser(userId uuid.UUID, page pagination.Page) ([]model.Deck, string, error)
+	ReadAllCardsFromDeck(deckId uuid.UUID, userId uuid.UUID, page pagination.Page) ([]modelCard.Card, string, error)
+	SearchAllPublicDecks(query string, page pagination.Page) ([]model.Deck, string, error)
+	SearchUserPublicDecks(userId string, query string, page pagination.Page) ([]model.Deck, string, error)
 	ReadDeck(deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error)
-	DeleteDeck(deckId uuid.UUID, userId uuid.UUID) error
+	UpdateDeck(deckId uuid.UUID, userId uuid.UUID, update *schemes.UpdateDeckScheme) (*model.Deck, error)
+	ReadDeckTree(deckId uuid.UUID, userId uuid.UUID) ([]model.Deck, error)
+	MoveDeck(deckId uuid.UUID, parentDeckId uuid.UUID, userId uuid.UUID) (*model.Deck, error)
+	ReadDueCounts(deckId uuid.UUID, userId uuid.UUID) ([]model.DueCount, error)
+	DeleteDeck(ctx context.Context, deckId uuid.UUID, userId uuid.UUID, mode string, targetDeckId uuid.UUID) error
+	ReadTrashedDecks(userId uuid.UUID) ([]model.Deck, error)
+	RestoreDeck(deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error)
+	CloneDeck(ctx context.Context, deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error)
+	SubscribeDeck(ctx context.Context, deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error)
+	UnsubscribeDeck(deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error)
 	AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) error
+	RemoveCardFromDeck(cardId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) error
+	MoveCards(cardIds []uuid.UUID, deckId uuid.UUID, userId uuid.UUID) (int, error)
+	ReadMembers(deckId uuid.UUID, userId uuid.UUID) ([]model.Member, error)
+	InviteMember(deckId uuid.UUID, userId uuid.UUID, memberId uuid.UUID, role string) (*model.Member, error)
+	RemoveMember(deckId uuid.UUID, userId uuid.UUID, memberId uuid.UUID) error
+	ReadOptions(deckId uuid.UUID, userId uuid.UUID) (*model.Options, error)
+	UpdateOptions(deckId uuid.UUID, userId uuid.UUID, options *model.Options) (*model.Options, error)
 }
@@ -9,9 +9,12 @@ import (
 	cardv1 "github.com/GOeda-Co/proto-contract/gen/go/card"
 	deckv1 "github.com/GOeda-Co/proto-contract/gen/go/deck"
 	"github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 	"github.com/google/uuid"
 	"github.com/tomatoCoderq/deck/internal/controller"
 	"github.com/tomatoCoderq/deck/internal/lib/security"
+	services "github.com/tomatoCoderq/deck/internal/services/deck"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -46,35 +49,50 @@ func (s *DeckServerAPI) AddDeck(ctx context.Context, in *deckv1.AddDeckRequest)
 		return nil, status.Error(codes.InvalidArgument, "Name is required")
 	}
 
-	// authUser, err := GetAuthUser(ctx)
-
-	// if err != nil {
-	// 	return nil, status.Error(codes.Unauthenticated, "User not authenticated")
-	// }
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
 
 	deck := &model.Deck{
+		CreatedBy:   authUser.ID,
 		Name:        in.Name,
 		Description: in.Description,
 		IsPublic:    in.IsPublic,
 	}
+	if in.ParentDeckId != "" {
+		parentDeckId, err := uuid.Parse(in.ParentDeckId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid parent deck ID")
+		}
+		deck.ParentDeckId = &parentDeckId
+	}
 
 	// deck, _ := convert.FromProtoToModelDeck(in)
 	createdDeck, err := s.service.AddDeck(deck)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidParent) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "Failed to add deck")
 	}
 
 	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(createdDeck)}, nil
 }
 
-func (s *DeckServerAPI) ReadAllDecks(ctx context.Context, in *emptypb.Empty) (*deckv1.DeckListResponse, error) {
+func (s *DeckServerAPI) ReadAllDecks(ctx context.Context, in *cardv1.PageRequest) (*deckv1.DeckListResponse, error) {
 	authUser, err := GetAuthUser(ctx)
 	fmt.Println("Auth User", authUser)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
 	}
 
-	decks, err := s.service.ReadAllDecksOfUser(authUser.ID)
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decks, next, err := s.service.ReadAllDecksOfUser(authUser.ID, page)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to fetch decks")
 	}
@@ -84,7 +102,7 @@ func (s *DeckServerAPI) ReadAllDecks(ctx context.Context, in *emptypb.Empty) (*d
 		protoDecks = append(protoDecks, convert.FromModelToProtoDeck(&deck))
 	}
 
-	return &deckv1.DeckListResponse{Decks: protoDecks}, nil
+	return &deckv1.DeckListResponse{Decks: protoDecks, NextCursor: next}, nil
 }
 
 func (s *DeckServerAPI) ReadDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckResponse, error) {
@@ -106,8 +124,131 @@ func (s *DeckServerAPI) ReadDeck(ctx context.Context, in *deckv1.ReadDeckRequest
 	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(deck)}, nil
 }
 
-func (s *DeckServerAPI) SearchAllPublicDecks(ctx context.Context, in *emptypb.Empty) (*deckv1.SearchAllPublicDecksResponse, error) {
-	decks, err := s.service.SearchAllPublicDecks()
+func (s *DeckServerAPI) UpdateDeck(ctx context.Context, in *deckv1.UpdateDeckRequest) (*deckv1.DeckResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	deck, err := s.service.UpdateDeck(deckId, authUser.ID, &schemes.UpdateDeckScheme{
+		Name:        in.Name,
+		Description: in.Description,
+		IsPublic:    in.IsPublic,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidDeck) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, services.ErrUnauthorized) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, services.ErrDeckNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to update deck: %v", err))
+	}
+
+	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(deck)}, nil
+}
+
+func (s *DeckServerAPI) ReadDeckTree(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckListResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	decks, err := s.service.ReadDeckTree(deckId, authUser.ID)
+	if err != nil {
+		return nil, treeError(err, "Failed to read deck tree")
+	}
+
+	protoDecks := make([]*deckv1.Deck, 0, len(decks))
+	for _, deck := range decks {
+		protoDecks = append(protoDecks, convert.FromModelToProtoDeck(&deck))
+	}
+
+	return &deckv1.DeckListResponse{Decks: protoDecks}, nil
+}
+
+func (s *DeckServerAPI) MoveDeck(ctx context.Context, in *deckv1.MoveDeckRequest) (*deckv1.DeckResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+	parentDeckId := uuid.Nil
+	if in.ParentDeckId != "" {
+		parentDeckId, err = uuid.Parse(in.ParentDeckId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid parent deck ID")
+		}
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	deck, err := s.service.MoveDeck(deckId, parentDeckId, authUser.ID)
+	if err != nil {
+		return nil, treeError(err, "Failed to move deck")
+	}
+
+	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(deck)}, nil
+}
+
+func (s *DeckServerAPI) ReadDeckDueCounts(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckDueCountsResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	counts, err := s.service.ReadDueCounts(deckId, authUser.ID)
+	if err != nil {
+		return nil, treeError(err, "Failed to count due cards")
+	}
+
+	protoCounts := make([]*deckv1.DeckDueCount, 0, len(counts))
+	for _, count := range counts {
+		protoCounts = append(protoCounts, convert.FromModelToProtoDueCount(&count))
+	}
+
+	return &deckv1.DeckDueCountsResponse{Counts: protoCounts}, nil
+}
+
+func treeError(err error, msg string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidParent), errors.Is(err, services.ErrDeckCycle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrDeckNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+}
+
+func (s *DeckServerAPI) SearchAllPublicDecks(ctx context.Context, in *deckv1.SearchPublicDecksRequest) (*deckv1.SearchAllPublicDecksResponse, error) {
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decks, next, err := s.service.SearchAllPublicDecks(in.Query, page)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to fetch public decks")
 	}
@@ -117,14 +258,19 @@ func (s *DeckServerAPI) SearchAllPublicDecks(ctx context.Context, in *emptypb.Em
 		protoDecks = append(protoDecks, convert.FromModelToProtoDeck(&deck))
 	}
 
-	return &deckv1.SearchAllPublicDecksResponse{Decks: protoDecks}, nil
+	return &deckv1.SearchAllPublicDecksResponse{Decks: protoDecks, NextCursor: next}, nil
 }
 
 func (s *DeckServerAPI) SearchUserPublicDecks(ctx context.Context, in *deckv1.SearchUserPublicDecksRequest) (*deckv1.SearchUserPublicDecksResponse, error) {
 	if in.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "User ID is required")
 	}
-	decks, err := s.service.SearchUserPublicDecks(in.UserId)
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decks, next, err := s.service.SearchUserPublicDecks(in.UserId, in.Query, page)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to fetch user's public decks")
 	}
@@ -133,10 +279,131 @@ func (s *DeckServerAPI) SearchUserPublicDecks(ctx context.Context, in *deckv1.Se
 	for _, deck := range decks {
 		protoDecks = append(protoDecks, convert.FromModelToProtoDeck(&deck))
 	}
-	return &deckv1.SearchUserPublicDecksResponse{Decks: protoDecks}, nil
+	return &deckv1.SearchUserPublicDecksResponse{Decks: protoDecks, NextCursor: next}, nil
+}
+
+func (s *DeckServerAPI) DeleteDeck(ctx context.Context, in *deckv1.DeleteDeckRequest) (*emptypb.Empty, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	var targetDeckId uuid.UUID
+	if in.TargetDeckId != "" {
+		if targetDeckId, err = uuid.Parse(in.TargetDeckId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid target deck ID")
+		}
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	err = s.service.DeleteDeck(ctx, deckId, authUser.ID, in.Mode, targetDeckId)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidDeleteMode) || errors.Is(err, services.ErrInvalidTargetDeck) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// Errors of card service keep their status
+		if st, ok := status.FromError(err); ok {
+			return nil, st.Err()
+		}
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *DeckServerAPI) ReadTrashedDecks(ctx context.Context, in *emptypb.Empty) (*deckv1.DeckListResponse, error) {
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	decks, err := s.service.ReadTrashedDecks(authUser.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch trashed decks")
+	}
+
+	var protoDecks []*deckv1.Deck
+	for _, deck := range decks {
+		protoDecks = append(protoDecks, convert.FromModelToProtoDeck(&deck))
+	}
+
+	return &deckv1.DeckListResponse{Decks: protoDecks}, nil
+}
+
+func (s *DeckServerAPI) RestoreDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	deck, err := s.service.RestoreDeck(deckId, authUser.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrDeckNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, services.ErrUnauthorized) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to restore deck: %v", err))
+	}
+
+	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(deck)}, nil
+}
+
+func (s *DeckServerAPI) CloneDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckResponse, error) {
+	return s.cloneDeck(ctx, in, s.service.CloneDeck)
+}
+
+func (s *DeckServerAPI) SubscribeDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckResponse, error) {
+	return s.cloneDeck(ctx, in, s.service.SubscribeDeck)
+}
+
+func (s *DeckServerAPI) cloneDeck(
+	ctx context.Context,
+	in *deckv1.ReadDeckRequest,
+	clone func(ctx context.Context, deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error),
+) (*deckv1.DeckResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	deck, err := clone(ctx, deckId, authUser.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrDeckNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, services.ErrUnauthorized) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, services.ErrOwnDeck) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// Errors of card service keep their status
+		if st, ok := status.FromError(err); ok {
+			return nil, st.Err()
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to clone deck: %v", err))
+	}
+
+	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(deck)}, nil
 }
 
-func (s *DeckServerAPI) DeleteDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*emptypb.Empty, error) {
+func (s *DeckServerAPI) UnsubscribeDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckResponse, error) {
 	deckId, err := uuid.Parse(in.DeckId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
@@ -147,14 +414,98 @@ func (s *DeckServerAPI) DeleteDeck(ctx context.Context, in *deckv1.ReadDeckReque
 		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
 	}
 
-	err = s.service.DeleteDeck(deckId, authUser.ID)
+	deck, err := s.service.UnsubscribeDeck(deckId, authUser.ID)
 	if err != nil {
+		if errors.Is(err, services.ErrNotSubscribed) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
+	return &deckv1.DeckResponse{Deck: convert.FromModelToProtoDeck(deck)}, nil
+}
+
+func (s *DeckServerAPI) ReadDeckMembers(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckMembersResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	members, err := s.service.ReadMembers(deckId, authUser.ID)
+	if err != nil {
+		return nil, memberError(err, "Failed to read deck members")
+	}
+
+	protoMembers := make([]*deckv1.DeckMember, 0, len(members))
+	for _, member := range members {
+		protoMembers = append(protoMembers, convert.FromModelToProtoDeckMember(&member))
+	}
+
+	return &deckv1.DeckMembersResponse{Members: protoMembers}, nil
+}
+
+func (s *DeckServerAPI) InviteDeckMember(ctx context.Context, in *deckv1.InviteDeckMemberRequest) (*deckv1.DeckMemberResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+	memberId, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid user ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	member, err := s.service.InviteMember(deckId, authUser.ID, memberId, in.Role)
+	if err != nil {
+		return nil, memberError(err, "Failed to invite deck member")
+	}
+
+	return &deckv1.DeckMemberResponse{Member: convert.FromModelToProtoDeckMember(member)}, nil
+}
+
+func (s *DeckServerAPI) RemoveDeckMember(ctx context.Context, in *deckv1.RemoveDeckMemberRequest) (*emptypb.Empty, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+	memberId, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid user ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	if err := s.service.RemoveMember(deckId, authUser.ID, memberId); err != nil {
+		return nil, memberError(err, "Failed to remove deck member")
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+func memberError(err error, msg string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidRole), errors.Is(err, services.ErrDeckAuthor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, services.ErrMemberNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+}
+
 func (s *DeckServerAPI) AddCardToDeck(ctx context.Context, in *deckv1.AddCardToDeckRequest) (*emptypb.Empty, error) {
 	cardId, err := uuid.Parse(in.CardId)
 	if err != nil {
@@ -172,23 +523,90 @@ func (s *DeckServerAPI) AddCardToDeck(ctx context.Context, in *deckv1.AddCardToD
 
 	err = s.service.AddCardToDeck(cardId, deckId, authUser.ID)
 	if err != nil {
-		return nil, status.Error(codes.PermissionDenied, err.Error())
+		return nil, cardsError(err, "Failed to add card to deck")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *DeckServerAPI) RemoveCardFromDeck(ctx context.Context, in *deckv1.RemoveCardFromDeckRequest) (*emptypb.Empty, error) {
+	cardId, err := uuid.Parse(in.CardId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+	}
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	if err := s.service.RemoveCardFromDeck(cardId, deckId, authUser.ID); err != nil {
+		return nil, cardsError(err, "Failed to remove card from deck")
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s *DeckServerAPI) ReadCardsFromDeck(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.CardListResponse, error) {
+func (s *DeckServerAPI) MoveCards(ctx context.Context, in *deckv1.MoveCardsRequest) (*deckv1.MoveCardsResponse, error) {
 	deckId, err := uuid.Parse(in.DeckId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
 	}
+	cardIds := make([]uuid.UUID, 0, len(in.CardIds))
+	for _, id := range in.CardIds {
+		cardId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid card ID")
+		}
+		cardIds = append(cardIds, cardId)
+	}
 
 	authUser, err := GetAuthUser(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
 	}
-	cards, err := s.service.ReadAllCardsFromDeck(deckId, authUser.ID)
+
+	moved, err := s.service.MoveCards(cardIds, deckId, authUser.ID)
+	if err != nil {
+		return nil, cardsError(err, "Failed to move cards")
+	}
+
+	return &deckv1.MoveCardsResponse{Moved: int32(moved)}, nil
+}
+
+func cardsError(err error, msg string) error {
+	switch {
+	case errors.Is(err, services.ErrNoCardsSelected), errors.Is(err, services.ErrTooManyCards),
+		errors.Is(err, services.ErrCardNotInDeck):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrCardNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+}
+
+func (s *DeckServerAPI) ReadCardsFromDeck(ctx context.Context, in *deckv1.ReadCardsFromDeckRequest) (*deckv1.CardListResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	page, err := pagination.New(in.Cursor, int(in.Limit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+	cards, next, err := s.service.ReadAllCardsFromDeck(deckId, authUser.ID, page)
 	if err != nil {
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
@@ -198,5 +616,50 @@ func (s *DeckServerAPI) ReadCardsFromDeck(ctx context.Context, in *deckv1.ReadDe
 		protoCards = append(protoCards, convert.FromModelToProtoCard(&card))
 	}
 
-	return &deckv1.CardListResponse{Cards: protoCards}, nil
+	return &deckv1.CardListResponse{Cards: protoCards, NextCursor: next}, nil
+}
+
+func (s *DeckServerAPI) ReadDeckOptions(ctx context.Context, in *deckv1.ReadDeckRequest) (*deckv1.DeckOptionsResponse, error) {
+	deckId, err := uuid.Parse(in.DeckId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	options, err := s.service.ReadOptions(deckId, authUser.ID)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return &deckv1.DeckOptionsResponse{Options: convert.FromModelToProtoDeckOptions(options)}, nil
+}
+
+func (s *DeckServerAPI) UpdateDeckOptions(ctx context.Context, in *deckv1.UpdateDeckOptionsRequest) (*deckv1.DeckOptionsResponse, error) {
+	if in.Options == nil {
+		return nil, status.Error(codes.InvalidArgument, "Options are required")
+	}
+
+	options, err := convert.FromProtoToModelDeckOptions(in.Options)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid deck ID")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "User not authenticated")
+	}
+
+	updated, err := s.service.UpdateOptions(options.DeckId, authUser.ID, options)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidOptions) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return &deckv1.DeckOptionsResponse{Options: convert.FromModelToProtoDeckOptions(updated)}, nil
 }
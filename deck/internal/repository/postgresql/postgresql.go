@@ -3,16 +3,19 @@ package postgresql
 import (
 	// "fmt"
 	"log/slog"
+	"time"
 
 	// model "github.com/tomatoCoderq/deck/pkg/model"
 	modelCard "github.com/GOeda-Co/proto-contract/model/card"
 	model "github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	"github.com/tomatoCoderq/deck/migrations"
 
 	"github.com/google/uuid"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -25,9 +28,12 @@ func New(connectionString string, log *slog.Logger) *Repository {
 		log.Error("Error during opening database")
 	}
 
-	migrations.MigrateToLatest(db, log)
+	if err = migrations.MigrateToLatest(db, log); err != nil {
+		log.Error("Error during migration", "error", err)
+		return nil
+	}
 
-	if err = db.AutoMigrate(&model.Deck{}); err != nil {
+	if err = db.AutoMigrate(&model.Deck{}, &model.Options{}, &model.Member{}); err != nil {
 		log.Error("Error during auto migration", "error", err)
 	}
 
@@ -38,76 +44,356 @@ func (r *Repository) AddDeck(deck *model.Deck) error {
 	return r.db.Create(deck).Error
 }
 
-func (r *Repository) ReadAllDecksOfUser(userId uuid.UUID) ([]model.Deck, error) {
+// roleColumn selects the role of the user in the deck: owner for its author, otherwise the member role
+const roleColumn = "CASE WHEN decks.created_by = ? THEN ? " +
+	"ELSE (SELECT role FROM deck_members WHERE deck_members.deck_id = decks.deck_id AND deck_members.user_id = ?) END AS role"
+
+// ReadAllDecksOfUser returns a page of decks the user wrote or is a member of,
+// with the role of the user. Lists don't preload cards, they are paged
+// separately by FindAllCardsInDeck
+func (r *Repository) ReadAllDecksOfUser(userId uuid.UUID, page pagination.Page) ([]model.Deck, error) {
 	var decks []model.Deck
-	err := r.db.Where("created_by = ?", userId).Preload("Cards").Find(&decks).Error
+	shared := r.db.Model(&model.Member{}).Select("deck_id").Where("user_id = ?", userId)
+	err := r.db.
+		Select("decks.*, "+roleColumn, userId, model.RoleOwner, userId).
+		Where("created_by = ? OR deck_id IN (?)", userId, shared).
+		Scopes(page.Scope("created_at", "deck_id")).
+		Find(&decks).Error
 	return decks, err
 }
 
-func (r *Repository) ReadAllDecks() ([]model.Deck, error) {
+func (r *Repository) ReadAllDecks(page pagination.Page) ([]model.Deck, error) {
 	var decks []model.Deck
-	err := r.db.Preload("Cards").Find(&decks).Error
+	err := r.db.Scopes(page.Scope("created_at", "deck_id")).Find(&decks).Error
 	return decks, err
 }
 
-func (r *Repository) SearchAllPublicDecks() ([]model.Deck, error) {
+// Full-text query matches stemmed english words as well as words of any
+// language as is, the same way decks_search_vector indexes them
+const (
+	searchQuery = "(SELECT websearch_to_tsquery('english', ?) || websearch_to_tsquery('simple', ?) AS query) AS search"
+	searchRank  = "ts_rank(decks.search_vector, search.query)"
+)
+
+// searchScope keeps decks matching the query, ranks and highlights them.
+// An empty query lists decks newest first
+func searchScope(query string, page pagination.Page) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if query == "" {
+			return db.Scopes(page.Scope("created_at", "deck_id"))
+		}
+		return db.
+			Joins("CROSS JOIN "+searchQuery, query, query).
+			Select("decks.*, " + searchRank + " AS rank, " +
+				"ts_headline('english', decks.name, search.query) AS name_highlight, " +
+				"ts_headline('english', decks.description, search.query) AS description_highlight").
+			Where("decks.search_vector @@ search.query").
+			Scopes(page.RankedScope(searchRank, "decks.created_at", "decks.deck_id"))
+	}
+}
+
+func (r *Repository) SearchAllPublicDecks(query string, page pagination.Page) ([]model.Deck, error) {
 	var decks []model.Deck
-	err := r.db.Where("is_public = ?", true).Preload("Cards").Find(&decks).Error
+	err := r.db.Where("is_public = ?", true).Scopes(searchScope(query, page)).Find(&decks).Error
 	return decks, err
 }
 
-func (r *Repository) SearchUserPublicDecks(userId uuid.UUID) ([]model.Deck, error) {
+func (r *Repository) SearchUserPublicDecks(userId uuid.UUID, query string, page pagination.Page) ([]model.Deck, error) {
 	var decks []model.Deck
-	err := r.db.Where("is_public = ? AND created_by = ?", true, userId).Preload("Cards").Find(&decks).Error
+	err := r.db.Where("is_public = ? AND created_by = ?", true, userId).Scopes(searchScope(query, page)).Find(&decks).Error
 	return decks, err
 }
 
+// ReadDeck reads the deck without its cards, they are read page by page with FindAllCardsInDeck
 func (r *Repository) ReadDeck(deckId uuid.UUID) (*model.Deck, error) {
 	var deck model.Deck
-	err := r.db.Where("deck_id = ?", deckId).Preload("Cards").First(&deck).Error
+	err := r.db.Where("deck_id = ?", deckId).First(&deck).Error
 	if err != nil {
 		return nil, err
 	}
 	return &deck, nil
 }
 
-func (r *Repository) DeleteDeck(deckId uuid.UUID) error {
-	return r.db.Delete(&model.Deck{}, "deck_id = ?", deckId).Error
+// UpdateDeck saves name, description and visibility of the deck. When visibility
+// changed, cards of the deck are updated to it in the same transaction
+func (r *Repository) UpdateDeck(deck *model.Deck, visibilityChanged bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Deck{}).
+			Where("deck_id = ?", deck.DeckId).
+			Updates(map[string]any{
+				"name":        deck.Name,
+				"description": deck.Description,
+				"is_public":   deck.IsPublic,
+			}).Error; err != nil {
+			return err
+		}
+		if !visibilityChanged {
+			return nil
+		}
+
+		return tx.Model(&modelCard.Card{}).
+			Where("deck_id = ?", deck.DeckId).
+			Update("is_public", deck.IsPublic).Error
+	})
 }
 
-func (r *Repository) FindAllCardsInDeck(deckId uuid.UUID) ([]modelCard.Card, error) {
-	var cards []modelCard.Card
-	err := r.db.Where("deck_id = ?", deckId).Find(&cards).Error
-	return cards, err
+// subtreeIds selects ids of the deck and all of its subdecks outside trash.
+// UNION drops decks already visited, so the walk ends even on a cycle
+const subtreeIds = `WITH RECURSIVE subtree AS (
+	SELECT deck_id FROM decks WHERE deck_id = ? AND deleted_at IS NULL
+	UNION
+	SELECT decks.deck_id FROM decks JOIN subtree ON decks.parent_deck_id = subtree.deck_id
+	WHERE decks.deleted_at IS NULL
+) SELECT deck_id FROM subtree`
+
+// ReadSubtree reads the deck with all of its subdecks, oldest first
+func (r *Repository) ReadSubtree(deckId uuid.UUID) ([]model.Deck, error) {
+	var decks []model.Deck
+	err := r.db.
+		Where("deck_id IN (?)", r.db.Raw(subtreeIds, deckId)).
+		Order("created_at, deck_id").
+		Find(&decks).Error
+	return decks, err
 }
 
-func (r *Repository) AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID) error {
-	var deck *model.Deck
-	var err error
+// SetParent nests the deck under the parent deck, nil makes it top-level
+func (r *Repository) SetParent(deckId uuid.UUID, parentDeckId *uuid.UUID) error {
+	return r.db.Model(&model.Deck{}).
+		Where("deck_id = ?", deckId).
+		Update("parent_deck_id", parentDeckId).Error
+}
 
-	tx := r.db.Begin()
+// CountDueCards counts cards of the user due now in each of the decks by
+// learning phase. Buried cards wait like in the study queue. Decks without due
+// cards are left out
+func (r *Repository) CountDueCards(deckIds []uuid.UUID, userId uuid.UUID) ([]model.DueCount, error) {
+	now := time.Now()
+	var counts []model.DueCount
+	err := r.db.Model(&modelCard.Card{}).
+		Select("deck_id, "+
+			"COUNT(*) FILTER (WHERE phase IN ('new', '')) AS new, "+
+			"COUNT(*) FILTER (WHERE phase IN ('learning', 'relearning')) AS learning, "+
+			"COUNT(*) FILTER (WHERE phase = 'review') AS review").
+		Where("deck_id IN ? AND created_by = ? AND expires_at < ?", deckIds, userId, now).
+		Where("buried_until IS NULL OR buried_until <= ?", now).
+		Group("deck_id").
+		Scan(&counts).Error
+	return counts, err
+}
 
-	if deck, err = r.ReadDeck(deckId); err != nil {
-		tx.Rollback()
-		return err
-	}
+// DeleteDeck moves the deck to trash. Cards trashed with the deck by card
+// service get the same deleted_at, so they can be restored together with it.
+// Subdecks stay and move up to parentDeckId, the parent of the deleted deck
+func (r *Repository) DeleteDeck(deckId uuid.UUID, parentDeckId *uuid.UUID, deletedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Deck{}).
+			Where("parent_deck_id = ?", deckId).
+			Update("parent_deck_id", parentDeckId).Error
+		if err != nil {
+			return err
+		}
 
-	// After adding a card to a deck, deck's is_public updated to deck's is_public
-	if err := tx.Model(&modelCard.Card{}).
-		Where("card_id = ?", cardId).
-		Update("deck_id", deckId).
-		Update("is_public", deck.IsPublic).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+		return tx.Model(&model.Deck{}).
+			Where("deck_id = ?", deckId).
+			Update("deleted_at", deletedAt).Error
+	})
+}
 
-	if err := tx.Model(&model.Deck{}).
+func (r *Repository) ReadTrashedDecks(userId uuid.UUID) ([]model.Deck, error) {
+	var decks []model.Deck
+	err := r.db.Unscoped().
+		Where("created_by = ? AND deleted_at IS NOT NULL", userId).
+		Order("deleted_at DESC").
+		Find(&decks).Error
+	return decks, err
+}
+
+func (r *Repository) ReadTrashedDeck(deckId uuid.UUID) (*model.Deck, error) {
+	var deck model.Deck
+	err := r.db.Unscoped().
+		Where("deck_id = ? AND deleted_at IS NOT NULL", deckId).
+		Find(&deck).Error
+	return &deck, err
+}
+
+// RestoreDeck takes the deck out of trash with cards trashed along with it.
+// Cards deleted on their own before the deck stay in trash
+func (r *Repository) RestoreDeck(deckId uuid.UUID, deletedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Model(&modelCard.Card{}).
+			Where("deck_id = ? AND deleted_at = ?", deckId, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Model(&model.Deck{}).
+			Where("deck_id = ?", deckId).
+			Update("deleted_at", nil).Error
+	})
+}
+
+// PurgeDecks permanently deletes decks trashed before the given time with their
+// options and members. Their cards are purged by card service
+func (r *Repository) PurgeDecks(before time.Time) (int64, error) {
+	var purged int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		trashed := tx.Unscoped().
+			Model(&model.Deck{}).
+			Select("deck_id").
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before)
+
+		if err := tx.Where("deck_id IN (?)", trashed).Delete(&model.Options{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("deck_id IN (?)", trashed).Delete(&model.Member{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Delete(&model.Deck{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	return purged, err
+}
+
+// RemoveDeck permanently deletes the deck with its options and members, bypassing trash
+func (r *Repository) RemoveDeck(deckId uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("deck_id = ?", deckId).Delete(&model.Options{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("deck_id = ?", deckId).Delete(&model.Member{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("deck_id = ?", deckId).Delete(&model.Deck{}).Error
+	})
+}
+
+func (r *Repository) SetSubscribed(deckId uuid.UUID, subscribed bool) error {
+	return r.db.Model(&model.Deck{}).
 		Where("deck_id = ?", deckId).
-		UpdateColumn("cards_quantity", gorm.Expr("cards_quantity + ?", 1)).Error; err != nil {
-		tx.Rollback()
+		Update("subscribed", subscribed).Error
+}
+
+func (r *Repository) FindAllCardsInDeck(deckId uuid.UUID, page pagination.Page) ([]modelCard.Card, error) {
+	var cards []modelCard.Card
+	err := r.db.Where("deck_id = ?", deckId).Scopes(page.Scope("created_at", "card_id")).Find(&cards).Error
+	return cards, err
+}
+
+// AddCardToDeck moves the card into the deck, see MoveCards
+func (r *Repository) AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID) error {
+	deck, err := r.ReadDeck(deckId)
+	if err != nil {
 		return err
 	}
+	_, err = r.MoveCards([]uuid.UUID{cardId}, deck)
+	return err
+}
+
+// ReadCards reads cards by id, without trashed ones
+func (r *Repository) ReadCards(cardIds []uuid.UUID) ([]modelCard.Card, error) {
+	var cards []modelCard.Card
+	err := r.db.Where("card_id IN ?", cardIds).Find(&cards).Error
+	return cards, err
+}
+
+// MoveCards moves cards into the target deck, cards already in it stay as they are.
+// Moved cards take visibility of the deck. Counts of the decks the cards left and
+// of the target deck change in the same transaction
+func (r *Repository) MoveCards(cardIds []uuid.UUID, target *model.Deck) (int64, error) {
+	var moved int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var sources []struct {
+			DeckId uuid.UUID
+			Count  int64
+		}
+		if err := tx.Model(&modelCard.Card{}).
+			Select("deck_id, COUNT(*) AS count").
+			Where("card_id IN ? AND deck_id IS DISTINCT FROM ?", cardIds, target.DeckId).
+			Group("deck_id").
+			Scan(&sources).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&modelCard.Card{}).
+			Where("card_id IN ? AND deck_id IS DISTINCT FROM ?", cardIds, target.DeckId).
+			Updates(map[string]any{"deck_id": target.DeckId, "is_public": target.IsPublic})
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+
+		for _, source := range sources {
+			if err := tx.Model(&model.Deck{}).
+				Where("deck_id = ?", source.DeckId).
+				UpdateColumn("cards_quantity", gorm.Expr("GREATEST(cards_quantity - ?, 0)", source.Count)).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.Deck{}).
+			Where("deck_id = ?", target.DeckId).
+			UpdateColumn("cards_quantity", gorm.Expr("cards_quantity + ?", moved)).Error
+	})
+	return moved, err
+}
+
+// RemoveCardFromDeck writes deck and visibility of the card taken out of the deck
+// and takes it off the count of the deck
+func (r *Repository) RemoveCardFromDeck(card *modelCard.Card, deckId uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&modelCard.Card{}).
+			Where("card_id = ? AND deck_id = ?", card.CardId, deckId).
+			Updates(map[string]any{"deck_id": card.DeckID, "is_public": card.IsPublic})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.Model(&model.Deck{}).
+			Where("deck_id = ?", deckId).
+			UpdateColumn("cards_quantity", gorm.Expr("GREATEST(cards_quantity - ?, 0)", result.RowsAffected)).Error
+	})
+}
+
+func (r *Repository) ReadOptions(deckId uuid.UUID) (*model.Options, error) {
+	var options model.Options
+	err := r.db.Where("deck_id = ?", deckId).Find(&options).Error
+	return &options, err
+}
+
+func (r *Repository) UpsertOptions(options *model.Options) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "deck_id"}},
+		UpdateAll: true,
+	}).Create(options).Error
+}
+
+// ReadMember returns membership of the user in the deck, empty when the user is not a member
+func (r *Repository) ReadMember(deckId uuid.UUID, userId uuid.UUID) (*model.Member, error) {
+	var member model.Member
+	err := r.db.Where("deck_id = ? AND user_id = ?", deckId, userId).Find(&member).Error
+	return &member, err
+}
+
+func (r *Repository) ReadMembers(deckId uuid.UUID) ([]model.Member, error) {
+	var members []model.Member
+	err := r.db.Where("deck_id = ?", deckId).Order("created_at").Find(&members).Error
+	return members, err
+}
+
+// UpsertMember adds the member or changes the role of an existing one
+func (r *Repository) UpsertMember(member *model.Member) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "deck_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "invited_by"}),
+	}).Create(member).Error
+}
 
-	return tx.Commit().Error
-	// return r.db.Model(&model.Card{}).Where("card_id = ?", cardId).Update("deck_id", deckId).Error
+func (r *Repository) DeleteMember(deckId uuid.UUID, userId uuid.UUID) error {
+	return r.db.Where("deck_id = ? AND user_id = ?", deckId, userId).Delete(&model.Member{}).Error
 }
@@ -1,42 +1,114 @@
 package services
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	modelCard "github.com/GOeda-Co/proto-contract/model/card"
 	"github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 
 	// "repeatro/src/deck/internal/repository/postgresql"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
-var ErrUnauthorized = errors.New("you do not own this deck")
+var (
+	ErrUnauthorized   = errors.New("your role in this deck does not allow this")
+	ErrInvalidOptions = errors.New("invalid deck options")
+	ErrDeckNotFound   = errors.New("deck not found")
+	ErrInvalidDeck    = fmt.Errorf("deck name must be 1-%d characters and description at most %d", MaxNameLength, MaxDescriptionLength)
+
+	ErrInvalidDeleteMode = errors.New("delete mode must be delete, move or detach")
+	ErrInvalidTargetDeck = errors.New("cards can be moved only to another deck the user can edit")
+
+	ErrOwnDeck       = errors.New("you can't subscribe to your own deck")
+	ErrNotSubscribed = errors.New("deck is not subscribed to a shared deck")
+
+	ErrCardNotFound    = errors.New("card not found")
+	ErrCardNotInDeck   = errors.New("card is not in the deck")
+	ErrNoCardsSelected = errors.New("no cards selected")
+	ErrTooManyCards    = fmt.Errorf("up to %d cards can be moved at once", MaxMoveCards)
+
+	ErrInvalidParent = errors.New("parent must be another deck of the same author you own")
+	ErrDeckCycle     = errors.New("a deck can't be nested under one of its subdecks")
+
+	ErrInvalidRole    = errors.New("role must be viewer, editor or owner")
+	ErrDeckAuthor     = errors.New("the author of the deck always owns it")
+	ErrMemberNotFound = errors.New("user is not a member of the deck")
+)
+
+// Limits of deck fields in characters
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 100
+)
+
+// MaxMoveCards limits cards moved by a single request
+const MaxMoveCards = 1000
 
 type DeckRepository interface {
 	AddDeck(deck *model.Deck) error
-	ReadAllDecksOfUser(userId uuid.UUID) ([]model.Deck, error)
-	ReadAllDecks() ([]model.Deck, error)
+	ReadAllDecksOfUser(userId uuid.UUID, page pagination.Page) ([]model.Deck, error)
+	ReadAllDecks(page pagination.Page) ([]model.Deck, error)
 	ReadDeck(deckId uuid.UUID) (*model.Deck, error)
-	SearchAllPublicDecks() ([]model.Deck, error)
-	SearchUserPublicDecks(userId uuid.UUID) ([]model.Deck, error)
-	DeleteDeck(deckId uuid.UUID) error
+	UpdateDeck(deck *model.Deck, visibilityChanged bool) error
+	SearchAllPublicDecks(query string, page pagination.Page) ([]model.Deck, error)
+	SearchUserPublicDecks(userId uuid.UUID, query string, page pagination.Page) ([]model.Deck, error)
+	DeleteDeck(deckId uuid.UUID, parentDeckId *uuid.UUID, deletedAt time.Time) error
+	ReadTrashedDecks(userId uuid.UUID) ([]model.Deck, error)
+	ReadTrashedDeck(deckId uuid.UUID) (*model.Deck, error)
+	RestoreDeck(deckId uuid.UUID, deletedAt time.Time) error
 	AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID) error
-	FindAllCardsInDeck(deckId uuid.UUID) ([]modelCard.Card, error)
+	ReadCards(cardIds []uuid.UUID) ([]modelCard.Card, error)
+	MoveCards(cardIds []uuid.UUID, target *model.Deck) (int64, error)
+	RemoveCardFromDeck(card *modelCard.Card, deckId uuid.UUID) error
+	FindAllCardsInDeck(deckId uuid.UUID, page pagination.Page) ([]modelCard.Card, error)
+	ReadOptions(deckId uuid.UUID) (*model.Options, error)
+	UpsertOptions(options *model.Options) error
+	RemoveDeck(deckId uuid.UUID) error
+	SetSubscribed(deckId uuid.UUID, subscribed bool) error
+	ReadMember(deckId uuid.UUID, userId uuid.UUID) (*model.Member, error)
+	ReadMembers(deckId uuid.UUID) ([]model.Member, error)
+	UpsertMember(member *model.Member) error
+	DeleteMember(deckId uuid.UUID, userId uuid.UUID) error
+	ReadSubtree(deckId uuid.UUID) ([]model.Deck, error)
+	SetParent(deckId uuid.UUID, parentDeckId *uuid.UUID) error
+	CountDueCards(deckIds []uuid.UUID, userId uuid.UUID) ([]model.DueCount, error)
+}
+
+// CardClient applies deck changes to cards owned by card service
+type CardClient interface {
+	ReleaseDeckCards(ctx context.Context, deckId uuid.UUID, mode string, targetDeckId uuid.UUID, deletedAt time.Time) (int, error)
+	CloneDeckCards(ctx context.Context, sourceDeckId uuid.UUID, deckId uuid.UUID) (int, error)
 }
 
 type Service struct {
 	DeckRepository DeckRepository
+	CardClient     CardClient
 }
 
-func New(log *slog.Logger, DeckRepository DeckRepository) *Service {
+func New(log *slog.Logger, DeckRepository DeckRepository, CardClient CardClient) *Service {
 	return &Service{
 		DeckRepository: DeckRepository,
+		CardClient:     CardClient,
 	}
 }
 
+// AddDeck creates the deck, nested under its parent deck if it has one
 func (ds *Service) AddDeck(deck *model.Deck) (*model.Deck, error) {
+	if deck.ParentDeckId != nil {
+		if err := ds.checkParent(deck, *deck.ParentDeckId, deck.CreatedBy); err != nil {
+			return nil, err
+		}
+	}
 	err := ds.DeckRepository.AddDeck(deck)
 	if err != nil {
 		return nil, err
@@ -44,60 +116,696 @@ func (ds *Service) AddDeck(deck *model.Deck) (*model.Deck, error) {
 	return deck, nil
 }
 
-func (ds *Service) ReadAllDecksOfUser(userId uuid.UUID) ([]model.Deck, error) {
-	return ds.DeckRepository.ReadAllDecksOfUser(userId)
+// ReadAllDecksOfUser returns a page of the user's decks and the cursor of the next page
+func (ds *Service) ReadAllDecksOfUser(userId uuid.UUID, page pagination.Page) ([]model.Deck, string, error) {
+	decks, err := ds.DeckRepository.ReadAllDecksOfUser(userId, page)
+	if err != nil {
+		return nil, "", err
+	}
+	decks, next := pagination.Trim(decks, page, deckCursor)
+	return decks, next, nil
+}
+
+func deckCursor(deck model.Deck) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: deck.CreatedAt, Id: deck.DeckId, Rank: deck.Rank}
+}
+
+func cardCursor(card modelCard.Card) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: card.CreatedAt, Id: card.CardId}
 }
 
+// ReadDeck returns the deck to its author and members of any role
 func (ds *Service) ReadDeck(deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error) {
-	deck, err := ds.DeckRepository.ReadDeck(deckId)
+	return ds.readDeck(deckId, userId, model.RoleViewer)
+}
+
+// UpdateDeck changes name, description and visibility of the deck, fields left
+// nil stay as they are. Cards of the deck follow its visibility
+func (ds *Service) UpdateDeck(deckId uuid.UUID, userId uuid.UUID, update *schemes.UpdateDeckScheme) (*model.Deck, error) {
+	deck, err := ds.readDeck(deckId, userId, model.RoleOwner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDeckNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if deck.CreatedBy != userId {
-		return nil, ErrUnauthorized
+	if update.Name != nil {
+		deck.Name = strings.TrimSpace(*update.Name)
+	}
+	if update.Description != nil {
+		deck.Description = strings.TrimSpace(*update.Description)
+	}
+	if deck.Name == "" || utf8.RuneCountInString(deck.Name) > MaxNameLength ||
+		utf8.RuneCountInString(deck.Description) > MaxDescriptionLength {
+		return nil, ErrInvalidDeck
+	}
+
+	visibilityChanged := update.IsPublic != nil && *update.IsPublic != deck.IsPublic
+	if update.IsPublic != nil {
+		deck.IsPublic = *update.IsPublic
+	}
+
+	if err := ds.DeckRepository.UpdateDeck(deck, visibilityChanged); err != nil {
+		return nil, err
 	}
 	return deck, nil
 }
 
-func (ds *Service) ReadAllCardsFromDeck(deckId uuid.UUID, userId uuid.UUID) ([]modelCard.Card, error) {
-	cards, err := ds.DeckRepository.FindAllCardsInDeck(deckId)
+// MoveDeck nests the deck with all of its subdecks under the parent deck,
+// uuid.Nil makes it top-level
+func (ds *Service) MoveDeck(deckId uuid.UUID, parentDeckId uuid.UUID, userId uuid.UUID) (*model.Deck, error) {
+	deck, err := ds.readDeck(deckId, userId, model.RoleOwner)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDeckNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
-	return cards, nil
+
+	var parent *uuid.UUID
+	if parentDeckId != uuid.Nil {
+		if err := ds.checkParent(deck, parentDeckId, userId); err != nil {
+			return nil, err
+		}
+		parent = &parentDeckId
+	}
+
+	if err := ds.DeckRepository.SetParent(deckId, parent); err != nil {
+		return nil, err
+	}
+	deck.ParentDeckId = parent
+	return deck, nil
 }
 
-func (ds *Service) SearchAllPublicDecks() ([]model.Deck, error) {
-	return ds.DeckRepository.SearchAllPublicDecks()
+// checkParent checks the deck may be nested under the parent: the user owns the
+// parent, it has the same author as the deck and isn't the deck or one of its subdecks
+func (ds *Service) checkParent(deck *model.Deck, parentDeckId uuid.UUID, userId uuid.UUID) error {
+	parent, err := ds.readDeck(parentDeckId, userId, model.RoleOwner)
+	if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, ErrUnauthorized) {
+		return ErrInvalidParent
+	}
+	if err != nil {
+		return err
+	}
+	if parent.CreatedBy != deck.CreatedBy {
+		return ErrInvalidParent
+	}
+	// A deck being created has no subdecks yet
+	if deck.DeckId == uuid.Nil {
+		return nil
+	}
+
+	subtree, err := ds.DeckRepository.ReadSubtree(deck.DeckId)
+	if err != nil {
+		return err
+	}
+	for _, subdeck := range subtree {
+		if subdeck.DeckId == parentDeckId {
+			return ErrDeckCycle
+		}
+	}
+	return nil
 }
 
-func (ds *Service) SearchUserPublicDecks(userId string) ([]model.Deck, error) {
-	userUUID, err := uuid.Parse(userId)
+// ReadDeckTree returns the deck with its subdecks outside trash, oldest first.
+// Subdecks the user can't read are left out together with their subdecks
+func (ds *Service) ReadDeckTree(deckId uuid.UUID, userId uuid.UUID) ([]model.Deck, error) {
+	_, err := ds.readDeck(deckId, userId, model.RoleViewer)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDeckNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	decks, err := ds.DeckRepository.ReadSubtree(deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[uuid.UUID][]uuid.UUID)
+	readable := make(map[uuid.UUID]bool, len(decks))
+	for i := range decks {
+		if parent := decks[i].ParentDeckId; parent != nil {
+			children[*parent] = append(children[*parent], decks[i].DeckId)
+		}
+		err := ds.authorize(&decks[i], userId, model.RoleViewer)
+		if err != nil && !errors.Is(err, ErrUnauthorized) {
+			return nil, err
+		}
+		readable[decks[i].DeckId] = err == nil
+	}
+
+	visible := map[uuid.UUID]bool{deckId: true}
+	queue := []uuid.UUID{deckId}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for _, child := range children[parent] {
+			if readable[child] && !visible[child] {
+				visible[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	tree := make([]model.Deck, 0, len(visible))
+	for _, deck := range decks {
+		if visible[deck.DeckId] {
+			tree = append(tree, deck)
+		}
+	}
+	return tree, nil
+}
+
+// ReadDueCounts counts the user's cards due today in the deck and each of its
+// subdecks, alone and together with all subdecks below it. Daily limits are
+// not applied. Counts are listed parents first, starting with the deck
+func (ds *Service) ReadDueCounts(deckId uuid.UUID, userId uuid.UUID) ([]model.DueCount, error) {
+	decks, err := ds.ReadDeckTree(deckId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	deckIds := make([]uuid.UUID, 0, len(decks))
+	for _, deck := range decks {
+		deckIds = append(deckIds, deck.DeckId)
+	}
+	counts, err := ds.DeckRepository.CountDueCards(deckIds, userId)
 	if err != nil {
 		return nil, err
 	}
-	return ds.DeckRepository.SearchUserPublicDecks(userUUID)
+	return sumDueCounts(decks, deckId, counts), nil
 }
 
-func (ds *Service) DeleteDeck(deckId uuid.UUID, userId uuid.UUID) error {
+// sumDueCounts walks the tree from the root, adding counts of subdecks to
+// totals of every deck above them
+func sumDueCounts(decks []model.Deck, rootId uuid.UUID, counts []model.DueCount) []model.DueCount {
+	own := make(map[uuid.UUID]model.DueCount, len(counts))
+	for _, count := range counts {
+		own[count.DeckId] = count
+	}
+
+	var root model.Deck
+	children := make(map[uuid.UUID][]model.Deck)
+	for _, deck := range decks {
+		if deck.DeckId == rootId {
+			root = deck
+		} else if deck.ParentDeckId != nil {
+			children[*deck.ParentDeckId] = append(children[*deck.ParentDeckId], deck)
+		}
+	}
+
+	result := make([]model.DueCount, 0, len(decks))
+	var walk func(deck model.Deck) model.DueCount
+	walk = func(deck model.Deck) model.DueCount {
+		c := own[deck.DeckId]
+		count := model.DueCount{
+			DeckId:        deck.DeckId,
+			ParentDeckId:  deck.ParentDeckId,
+			Name:          deck.Name,
+			New:           c.New,
+			Learning:      c.Learning,
+			Review:        c.Review,
+			TotalNew:      c.New,
+			TotalLearning: c.Learning,
+			TotalReview:   c.Review,
+		}
+		at := len(result)
+		result = append(result, count)
+		for _, child := range children[deck.DeckId] {
+			sub := walk(child)
+			count.TotalNew += sub.TotalNew
+			count.TotalLearning += sub.TotalLearning
+			count.TotalReview += sub.TotalReview
+		}
+		result[at] = count
+		return count
+	}
+	walk(root)
+	return result
+}
+
+// readDeck returns the deck with the role of the user if the role grants the required one
+func (ds *Service) readDeck(deckId uuid.UUID, userId uuid.UUID, required string) (*model.Deck, error) {
 	deck, err := ds.DeckRepository.ReadDeck(deckId)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	if err := ds.authorize(deck, userId, required); err != nil {
+		return nil, err
+	}
+	return deck, nil
+}
+
+// authorize fills the role of the user in the deck and checks it grants the required one.
+// The author of the deck owns it, other users get roles as deck members
+func (ds *Service) authorize(deck *model.Deck, userId uuid.UUID, required string) error {
+	deck.Role = model.RoleOwner
 	if deck.CreatedBy != userId {
+		member, err := ds.DeckRepository.ReadMember(deck.DeckId, userId)
+		if err != nil {
+			return err
+		}
+		deck.Role = member.Role
+	}
+	if !model.RoleAllows(deck.Role, required) {
 		return ErrUnauthorized
 	}
-	return ds.DeckRepository.DeleteDeck(deckId)
+	return nil
 }
 
-func (ds *Service) AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) error {
-	deck, err := ds.DeckRepository.ReadDeck(deckId)
+// ReadAllCardsFromDeck lists cards of a deck the user can read
+func (ds *Service) ReadAllCardsFromDeck(deckId uuid.UUID, userId uuid.UUID, page pagination.Page) ([]modelCard.Card, string, error) {
+	if _, err := ds.readDeck(deckId, userId, model.RoleViewer); err != nil {
+		return nil, "", err
+	}
+
+	cards, err := ds.DeckRepository.FindAllCardsInDeck(deckId, page)
+	if err != nil {
+		return nil, "", err
+	}
+	cards, next := pagination.Trim(cards, page, cardCursor)
+	return cards, next, nil
+}
+
+// SearchAllPublicDecks lists public decks newest first or, given a full-text
+// query, the matching ones ranked by relevance
+func (ds *Service) SearchAllPublicDecks(query string, page pagination.Page) ([]model.Deck, string, error) {
+	decks, err := ds.DeckRepository.SearchAllPublicDecks(strings.TrimSpace(query), page)
+	if err != nil {
+		return nil, "", err
+	}
+	decks, next := pagination.Trim(decks, page, deckCursor)
+	return decks, next, nil
+}
+
+func (ds *Service) SearchUserPublicDecks(userId string, query string, page pagination.Page) ([]model.Deck, string, error) {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, "", err
+	}
+	decks, err := ds.DeckRepository.SearchUserPublicDecks(userUUID, strings.TrimSpace(query), page)
+	if err != nil {
+		return nil, "", err
+	}
+	decks, next := pagination.Trim(decks, page, deckCursor)
+	return decks, next, nil
+}
+
+// DeleteDeck moves the deck to trash, it is purged after the retention period.
+// Depending on mode its cards go to trash with it, move to another deck of the user or stay without a deck.
+// Subdecks are not deleted, they take the place of the deck under its parent
+func (ds *Service) DeleteDeck(ctx context.Context, deckId uuid.UUID, userId uuid.UUID, mode string, targetDeckId uuid.UUID) error {
+	deck, err := ds.readDeck(deckId, userId, model.RoleOwner)
 	if err != nil {
 		return err
 	}
-	if deck.CreatedBy != userId {
-		return ErrUnauthorized
+
+	switch mode {
+	case "":
+		mode = model.DeleteModeCards
+	case model.DeleteModeCards, model.DeleteModeDetach:
+	case model.DeleteModeMove:
+		if targetDeckId == uuid.Nil || targetDeckId == deckId {
+			return ErrInvalidTargetDeck
+		}
+		if _, err := ds.readDeck(targetDeckId, userId, model.RoleEditor); err != nil {
+			return ErrInvalidTargetDeck
+		}
+	default:
+		return ErrInvalidDeleteMode
+	}
+
+	// Cards are released first, so a failure never leaves them pointing at a deleted deck
+	deletedAt := time.Now().Truncate(time.Microsecond)
+	if _, err := ds.CardClient.ReleaseDeckCards(ctx, deckId, mode, targetDeckId, deletedAt); err != nil {
+		return err
+	}
+
+	return ds.DeckRepository.DeleteDeck(deckId, deck.ParentDeckId, deletedAt)
+}
+
+// ReadTrashedDecks returns decks of the user in trash, recently deleted first
+func (ds *Service) ReadTrashedDecks(userId uuid.UUID) ([]model.Deck, error) {
+	return ds.DeckRepository.ReadTrashedDecks(userId)
+}
+
+// RestoreDeck takes the deck out of trash together with cards deleted along with it
+func (ds *Service) RestoreDeck(deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error) {
+	deck, err := ds.DeckRepository.ReadTrashedDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if deck.DeckId == uuid.Nil {
+		return nil, ErrDeckNotFound
+	}
+	if err := ds.authorize(deck, userId, model.RoleOwner); err != nil {
+		return nil, err
+	}
+
+	if err := ds.DeckRepository.RestoreDeck(deckId, deck.DeletedAt.Time); err != nil {
+		return nil, err
+	}
+
+	deck.DeletedAt = gorm.DeletedAt{}
+	return deck, nil
+}
+
+// CloneDeck copies a public or shared deck with its options and cards to the user.
+// The copy is private, its cards start with fresh scheduling
+func (ds *Service) CloneDeck(ctx context.Context, deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error) {
+	source, err := ds.readSourceDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if !source.IsPublic {
+		if err := ds.authorize(source, userId, model.RoleViewer); err != nil {
+			return nil, err
+		}
+	}
+	return ds.cloneDeck(ctx, source, userId, false)
+}
+
+// SubscribeDeck clones a public deck of another user as a copy following its
+// updates. The copy starts at the last published version of the deck
+func (ds *Service) SubscribeDeck(ctx context.Context, deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error) {
+	source, err := ds.readSourceDeck(deckId)
+	if err != nil {
+		return nil, err
+	}
+	if source.CreatedBy == userId {
+		return nil, ErrOwnDeck
+	}
+	if !source.IsPublic {
+		return nil, ErrUnauthorized
+	}
+	return ds.cloneDeck(ctx, source, userId, true)
+}
+
+// UnsubscribeDeck stops merging updates of the source deck, the copy stays as it is
+func (ds *Service) UnsubscribeDeck(deckId uuid.UUID, userId uuid.UUID) (*model.Deck, error) {
+	deck, err := ds.readDeck(deckId, userId, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !deck.Subscribed {
+		return nil, ErrNotSubscribed
+	}
+
+	if err := ds.DeckRepository.SetSubscribed(deckId, false); err != nil {
+		return nil, err
+	}
+	deck.Subscribed = false
+	return deck, nil
+}
+
+func (ds *Service) readSourceDeck(deckId uuid.UUID) (*model.Deck, error) {
+	source, err := ds.DeckRepository.ReadDeck(deckId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDeckNotFound
+	}
+	return source, err
+}
+
+func (ds *Service) cloneDeck(ctx context.Context, source *model.Deck, userId uuid.UUID, subscribe bool) (*model.Deck, error) {
+	clone := &model.Deck{
+		CreatedBy:    userId,
+		Name:         source.Name,
+		Description:  source.Description,
+		SourceDeckId: &source.DeckId,
+	}
+	if subscribe {
+		clone.Subscribed = true
+		clone.SyncedVersion = source.Version
+	}
+	if err := ds.DeckRepository.AddDeck(clone); err != nil {
+		return nil, err
+	}
+
+	// The copy is removed on failure, so a retry doesn't leave a half cloned deck behind
+	count, err := ds.cloneDeckContent(ctx, source.DeckId, clone.DeckId)
+	if err != nil {
+		if err := ds.DeckRepository.RemoveDeck(clone.DeckId); err != nil {
+			return nil, fmt.Errorf("failed to remove deck after failed clone: %w", err)
+		}
+		return nil, err
+	}
+
+	clone.CardsQuantity = uint(count)
+	return clone, nil
+}
+
+func (ds *Service) cloneDeckContent(ctx context.Context, sourceDeckId uuid.UUID, deckId uuid.UUID) (int, error) {
+	options, err := ds.DeckRepository.ReadOptions(sourceDeckId)
+	if err != nil {
+		return 0, err
+	}
+	if options.DeckId != uuid.Nil {
+		options.DeckId = deckId
+		options.UpdatedAt = time.Now()
+		if err := ds.DeckRepository.UpsertOptions(options); err != nil {
+			return 0, err
+		}
+	}
+	return ds.CardClient.CloneDeckCards(ctx, sourceDeckId, deckId)
+}
+
+// AddCardToDeck moves the card into the deck, taking it out of the deck it was in
+func (ds *Service) AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) error {
+	if _, err := ds.readDeck(deckId, userId, model.RoleEditor); err != nil {
+		return err
+	}
+	if err := ds.authorizeMove([]uuid.UUID{cardId}, userId); err != nil {
+		return err
 	}
 	return ds.DeckRepository.AddCardToDeck(cardId, deckId)
 }
+
+// RemoveCardFromDeck takes the card out of the deck, the card stays without a deck
+// and is no longer public
+func (ds *Service) RemoveCardFromDeck(cardId uuid.UUID, deckId uuid.UUID, userId uuid.UUID) error {
+	if _, err := ds.readDeck(deckId, userId, model.RoleEditor); err != nil {
+		return err
+	}
+	cards, err := ds.DeckRepository.ReadCards([]uuid.UUID{cardId})
+	if err != nil {
+		return err
+	}
+	if len(cards) == 0 {
+		return ErrCardNotFound
+	}
+	card := cards[0]
+	if card.DeckID != deckId {
+		return ErrCardNotInDeck
+	}
+
+	// Cards take visibility from their deck, without one they are private
+	card.DeckID = uuid.Nil
+	card.IsPublic = false
+	return ds.DeckRepository.RemoveCardFromDeck(&card, deckId)
+}
+
+// MoveCards moves cards into the deck in one transaction, all of them or none.
+// Returns how many cards moved, cards already in the deck are not counted
+func (ds *Service) MoveCards(cardIds []uuid.UUID, deckId uuid.UUID, userId uuid.UUID) (int, error) {
+	if len(cardIds) == 0 {
+		return 0, ErrNoCardsSelected
+	}
+	if len(cardIds) > MaxMoveCards {
+		return 0, ErrTooManyCards
+	}
+	target, err := ds.readDeck(deckId, userId, model.RoleEditor)
+	if err != nil {
+		return 0, err
+	}
+	if err := ds.authorizeMove(cardIds, userId); err != nil {
+		return 0, err
+	}
+
+	moved, err := ds.DeckRepository.MoveCards(cardIds, target)
+	if err != nil {
+		return 0, err
+	}
+	return int(moved), nil
+}
+
+// authorizeMove checks the user may take each of the cards out of where it is:
+// authors move their cards, other users need to edit the deck the card is in
+func (ds *Service) authorizeMove(cardIds []uuid.UUID, userId uuid.UUID) error {
+	cards, err := ds.DeckRepository.ReadCards(cardIds)
+	if err != nil {
+		return err
+	}
+	found := make(map[uuid.UUID]bool, len(cards))
+	for _, card := range cards {
+		found[card.CardId] = true
+	}
+	for _, cardId := range cardIds {
+		if !found[cardId] {
+			return ErrCardNotFound
+		}
+	}
+
+	editable := make(map[uuid.UUID]bool)
+	for _, card := range cards {
+		if card.CreatedBy == userId {
+			continue
+		}
+		if card.DeckID == uuid.Nil {
+			return ErrUnauthorized
+		}
+		allowed, ok := editable[card.DeckID]
+		if !ok {
+			_, err := ds.readDeck(card.DeckID, userId, model.RoleEditor)
+			if err != nil && !errors.Is(err, ErrUnauthorized) && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			allowed = err == nil
+			editable[card.DeckID] = allowed
+		}
+		if !allowed {
+			return ErrUnauthorized
+		}
+	}
+	return nil
+}
+
+// ReadMembers lists users sharing the deck with its author, first invited first
+func (ds *Service) ReadMembers(deckId uuid.UUID, userId uuid.UUID) ([]model.Member, error) {
+	if _, err := ds.readDeck(deckId, userId, model.RoleViewer); err != nil {
+		return nil, err
+	}
+	return ds.DeckRepository.ReadMembers(deckId)
+}
+
+// InviteMember gives the user a role in the deck, inviting a member again changes the role.
+// Only owners manage members
+func (ds *Service) InviteMember(deckId uuid.UUID, userId uuid.UUID, memberId uuid.UUID, role string) (*model.Member, error) {
+	if !model.ValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+	deck, err := ds.readDeck(deckId, userId, model.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if memberId == deck.CreatedBy {
+		return nil, ErrDeckAuthor
+	}
+
+	member, err := ds.DeckRepository.ReadMember(deckId, memberId)
+	if err != nil {
+		return nil, err
+	}
+	member.DeckId = deckId
+	member.UserId = memberId
+	member.Role = role
+	member.InvitedBy = userId
+	if err := ds.DeckRepository.UpsertMember(member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveMember takes the role of the member away. Owners remove any member,
+// other members can only leave the deck themselves
+func (ds *Service) RemoveMember(deckId uuid.UUID, userId uuid.UUID, memberId uuid.UUID) error {
+	required := model.RoleOwner
+	if memberId == userId {
+		required = model.RoleViewer
+	}
+	if _, err := ds.readDeck(deckId, userId, required); err != nil {
+		return err
+	}
+
+	member, err := ds.DeckRepository.ReadMember(deckId, memberId)
+	if err != nil {
+		return err
+	}
+	if member.Role == "" {
+		return ErrMemberNotFound
+	}
+	return ds.DeckRepository.DeleteMember(deckId, memberId)
+}
+
+func (ds *Service) ReadOptions(deckId uuid.UUID, userId uuid.UUID) (*model.Options, error) {
+	if _, err := ds.readDeck(deckId, userId, model.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	options, err := ds.DeckRepository.ReadOptions(deckId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Options of the deck were never changed yet
+	if options.DeckId == uuid.Nil {
+		defaults := model.DefaultOptions(deckId)
+		return &defaults, nil
+	}
+	return options, nil
+}
+
+// UpdateOptions replaces options of the deck. Omitted intervals and order fall back to defaults,
+// zero daily limits are kept as is
+func (ds *Service) UpdateOptions(deckId uuid.UUID, userId uuid.UUID, options *model.Options) (*model.Options, error) {
+	if _, err := ds.readDeck(deckId, userId, model.RoleOwner); err != nil {
+		return nil, err
+	}
+
+	options.DeckId = deckId
+	options.UpdatedAt = time.Now()
+	if options.GraduatingInterval == 0 {
+		options.GraduatingInterval = model.DefaultGraduatingInterval
+	}
+	if options.EasyInterval == 0 {
+		options.EasyInterval = model.DefaultEasyInterval
+	}
+	if options.MaximumInterval == 0 {
+		options.MaximumInterval = model.DefaultMaximumInterval
+	}
+	if options.NewCardOrder == "" {
+		options.NewCardOrder = model.NewCardOrderAdded
+	}
+
+	if err := validateOptions(options); err != nil {
+		return nil, err
+	}
+
+	if err := ds.DeckRepository.UpsertOptions(options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+func validateOptions(options *model.Options) error {
+	for _, step := range append(options.LearningSteps, options.RelearningSteps...) {
+		if step < 1 {
+			return fmt.Errorf("%w: steps must be at least 1 minute", ErrInvalidOptions)
+		}
+	}
+	if options.GraduatingInterval < 1 {
+		return fmt.Errorf("%w: graduating interval must be at least 1 day", ErrInvalidOptions)
+	}
+	if options.EasyInterval < options.GraduatingInterval {
+		return fmt.Errorf("%w: easy interval must not be less than graduating interval", ErrInvalidOptions)
+	}
+	if options.MaximumInterval < options.GraduatingInterval || options.MaximumInterval > model.DefaultMaximumInterval {
+		return fmt.Errorf("%w: maximum interval must be between graduating interval and %d days", ErrInvalidOptions, model.DefaultMaximumInterval)
+	}
+	if options.NewPerDay < 0 || options.MaxReviewsPerDay < 0 {
+		return fmt.Errorf("%w: daily limits must not be negative", ErrInvalidOptions)
+	}
+	switch options.Algorithm {
+	case "", "sm2", "fsrs":
+	default:
+		return fmt.Errorf("%w: unknown algorithm %q", ErrInvalidOptions, options.Algorithm)
+	}
+	switch options.NewCardOrder {
+	case model.NewCardOrderAdded, model.NewCardOrderRandom:
+	default:
+		return fmt.Errorf("%w: unknown new card order %q", ErrInvalidOptions, options.NewCardOrder)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Repository interface {
+	PurgeDecks(before time.Time) (int64, error)
+}
+
+type Config struct {
+	Retention     time.Duration
+	PurgeInterval time.Duration
+}
+
+// Purger permanently deletes decks that stayed in trash longer than retention
+type Purger struct {
+	log  *slog.Logger
+	repo Repository
+	cfg  Config
+}
+
+func New(log *slog.Logger, repo Repository, cfg Config) *Purger {
+	if cfg.Retention <= 0 {
+		cfg.Retention = 30 * 24 * time.Hour
+	}
+	if cfg.PurgeInterval <= 0 {
+		cfg.PurgeInterval = time.Hour
+	}
+
+	return &Purger{
+		log:  log,
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// Run purges expired decks every purge interval until ctx is done
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Purge(time.Now()); err != nil {
+			p.log.Error("Failed to purge trash", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes decks trashed before now minus retention and returns how many
+func (p *Purger) Purge(now time.Time) (int64, error) {
+	purged, err := p.repo.PurgeDecks(now.Add(-p.cfg.Retention))
+	if err != nil {
+		return 0, err
+	}
+	if purged > 0 {
+		p.log.Info("Purged decks from trash", "count", purged)
+	}
+	return purged, nil
+}
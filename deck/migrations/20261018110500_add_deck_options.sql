@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Per-deck study settings, steps are in minutes and intervals in days
+CREATE TABLE IF NOT EXISTS deck_options (
+    deck_id UUID PRIMARY KEY REFERENCES decks(deck_id) ON DELETE CASCADE,
+    learning_steps INTEGER[],
+    relearning_steps INTEGER[],
+    graduating_interval INTEGER DEFAULT 1 NOT NULL,
+    easy_interval INTEGER DEFAULT 4 NOT NULL,
+    maximum_interval INTEGER DEFAULT 36500 NOT NULL,
+    updated_at TIMESTAMP
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS deck_options;
+
+-- +goose StatementEnd
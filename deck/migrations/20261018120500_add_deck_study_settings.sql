@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Daily limits, scheduler override and new card order of the deck
+ALTER TABLE deck_options
+    ADD COLUMN IF NOT EXISTS new_per_day INTEGER DEFAULT 20 NOT NULL,
+    ADD COLUMN IF NOT EXISTS max_reviews_per_day INTEGER DEFAULT 200 NOT NULL,
+    ADD COLUMN IF NOT EXISTS algorithm VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS bury_siblings BOOLEAN DEFAULT FALSE NOT NULL,
+    ADD COLUMN IF NOT EXISTS new_card_order VARCHAR(16) DEFAULT 'added' NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE deck_options
+    DROP COLUMN IF EXISTS new_card_order,
+    DROP COLUMN IF EXISTS bury_siblings,
+    DROP COLUMN IF EXISTS algorithm,
+    DROP COLUMN IF EXISTS max_reviews_per_day,
+    DROP COLUMN IF EXISTS new_per_day;
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Deleted decks stay in trash until purged
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_decks_deleted_at ON decks(deleted_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_decks_deleted_at;
+ALTER TABLE decks DROP COLUMN IF EXISTS deleted_at;
+
+-- +goose StatementEnd
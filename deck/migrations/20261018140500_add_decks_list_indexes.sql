@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Keyset pagination of deck lists, newest first
+CREATE INDEX IF NOT EXISTS idx_decks_created_by_created_at ON decks(created_by, created_at DESC, deck_id DESC);
+CREATE INDEX IF NOT EXISTS idx_decks_public_created_at ON decks(created_at DESC, deck_id DESC) WHERE is_public;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_decks_public_created_at;
+DROP INDEX IF EXISTS idx_decks_created_by_created_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Full-text search document of a deck, name weighs more than description.
+-- Text is indexed both stemmed (english) and as is (simple), the same way as cards
+CREATE OR REPLACE FUNCTION decks_search_vector(name TEXT, description TEXT)
+RETURNS tsvector
+LANGUAGE sql IMMUTABLE
+AS $$
+    SELECT setweight(to_tsvector('english', coalesce(name, '')) || to_tsvector('simple', coalesce(name, '')), 'A')
+        || setweight(to_tsvector('english', coalesce(description, '')) || to_tsvector('simple', coalesce(description, '')), 'B')
+$$;
+
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (decks_search_vector(name, description)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_decks_search_vector ON decks USING GIN (search_vector);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_decks_search_vector;
+ALTER TABLE decks DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS decks_search_vector(TEXT, TEXT);
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Cloned decks refer back to the deck they were copied from
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS source_deck_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_decks_source_deck_id ON decks(source_deck_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_decks_source_deck_id;
+ALTER TABLE decks DROP COLUMN IF EXISTS source_deck_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Shared decks are published in versions, subscribed copies remember the version they are at
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS subscribed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS synced_version INTEGER NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE decks DROP COLUMN IF EXISTS synced_version;
+ALTER TABLE decks DROP COLUMN IF EXISTS subscribed;
+ALTER TABLE decks DROP COLUMN IF EXISTS version;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Users sharing a deck with its author as viewer, editor or owner
+CREATE TABLE IF NOT EXISTS deck_members (
+    deck_id UUID NOT NULL REFERENCES decks(deck_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    invited_by UUID,
+    created_at TIMESTAMP,
+    PRIMARY KEY (deck_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deck_members_user_id ON deck_members (user_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS deck_members;
+
+-- +goose StatementEnd
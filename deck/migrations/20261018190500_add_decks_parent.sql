@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Decks nest into a tree. Subdecks of a permanently deleted deck become top-level
+ALTER TABLE decks ADD COLUMN IF NOT EXISTS parent_deck_id UUID REFERENCES decks(deck_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_decks_parent_deck_id ON decks(parent_deck_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_decks_parent_deck_id;
+ALTER TABLE decks DROP COLUMN IF EXISTS parent_deck_id;
+
+-- +goose StatementEnd
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/tomatoCoderq/deck/internal/config"
@@ -59,7 +60,7 @@ func TestReadAllDecksOfUser(t *testing.T) {
 
 	_ = testRepo.AddDeck(deck)
 
-	decks, err := testRepo.ReadAllDecksOfUser(userId)
+	decks, err := testRepo.ReadAllDecksOfUser(userId, pagination.Page{Limit: pagination.DefaultLimit})
 	assert.NoError(t, err)
 	assert.True(t, len(decks) > 0)
 	assert.Equal(t, userId, decks[0].CreatedBy)
@@ -75,9 +76,30 @@ func TestDeleteDeck(t *testing.T) {
 
 	_ = testRepo.AddDeck(deck)
 
-	err := testRepo.DeleteDeck(deck.DeckId)
+	err := testRepo.DeleteDeck(deck.DeckId, nil, time.Now())
 	assert.NoError(t, err)
 
 	_, err = testRepo.ReadDeck(deck.DeckId)
 	assert.Error(t, err)
 }
+
+func TestDeleteDeck_Subdecks(t *testing.T) {
+	userId := uuid.New()
+	root := &model.Deck{DeckId: uuid.New(), Name: "Root", CreatedBy: userId, CreatedAt: time.Now()}
+	middle := &model.Deck{DeckId: uuid.New(), Name: "Middle", CreatedBy: userId, CreatedAt: time.Now(), ParentDeckId: &root.DeckId}
+	leaf := &model.Deck{DeckId: uuid.New(), Name: "Leaf", CreatedBy: userId, CreatedAt: time.Now(), ParentDeckId: &middle.DeckId}
+	for _, deck := range []*model.Deck{root, middle, leaf} {
+		assert.NoError(t, testRepo.AddDeck(deck))
+	}
+
+	err := testRepo.DeleteDeck(middle.DeckId, middle.ParentDeckId, time.Now())
+	assert.NoError(t, err)
+
+	subtree, err := testRepo.ReadSubtree(root.DeckId)
+	assert.NoError(t, err)
+	var ids []uuid.UUID
+	for _, deck := range subtree {
+		ids = append(ids, deck.DeckId)
+	}
+	assert.ElementsMatch(t, []uuid.UUID{root.DeckId, leaf.DeckId}, ids)
+}
@@ -1,6 +1,8 @@
 package services_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +13,9 @@ import (
 	// schemes "github.com/GOeda-Co/proto-contract/scheme/deck"
 	modelCard "github.com/GOeda-Co/proto-contract/model/card"
 	"github.com/GOeda-Co/proto-contract/model/deck"
+	"github.com/GOeda-Co/proto-contract/pagination"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"gorm.io/gorm"
 )
 
 type MockDeckRepository struct {
@@ -22,13 +27,13 @@ func (m *MockDeckRepository) AddDeck(deck *model.Deck) error {
 	return args.Error(0)
 }
 
-func (m *MockDeckRepository) ReadAllDecksOfUser(userId uuid.UUID) ([]model.Deck, error) {
-	args := m.Called(userId)
+func (m *MockDeckRepository) ReadAllDecksOfUser(userId uuid.UUID, page pagination.Page) ([]model.Deck, error) {
+	args := m.Called(userId, page)
 	return args.Get(0).([]model.Deck), args.Error(1)
 }
 
-func (m *MockDeckRepository) ReadAllDecks() ([]model.Deck, error) {
-	args := m.Called()
+func (m *MockDeckRepository) ReadAllDecks(page pagination.Page) ([]model.Deck, error) {
+	args := m.Called(page)
 	return args.Get(0).([]model.Deck), args.Error(1)
 }
 
@@ -37,17 +42,32 @@ func (m *MockDeckRepository) ReadDeck(deckId uuid.UUID) (*model.Deck, error) {
 	return args.Get(0).(*model.Deck), args.Error(1)
 }
 
-func (m *MockDeckRepository) DeleteDeck(deckId uuid.UUID) error {
+func (m *MockDeckRepository) DeleteDeck(deckId uuid.UUID, parentDeckId *uuid.UUID, deletedAt time.Time) error {
+	args := m.Called(deckId, parentDeckId, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) ReadTrashedDecks(userId uuid.UUID) ([]model.Deck, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]model.Deck), args.Error(1)
+}
+
+func (m *MockDeckRepository) ReadTrashedDeck(deckId uuid.UUID) (*model.Deck, error) {
 	args := m.Called(deckId)
+	return args.Get(0).(*model.Deck), args.Error(1)
+}
+
+func (m *MockDeckRepository) RestoreDeck(deckId uuid.UUID, deletedAt time.Time) error {
+	args := m.Called(deckId, deletedAt)
 	return args.Error(0)
 }
 
-func (m *MockDeckRepository) SearchAllPublicDecks() ([]model.Deck, error) {
-	args := m.Called()
+func (m *MockDeckRepository) SearchAllPublicDecks(query string, page pagination.Page) ([]model.Deck, error) {
+	args := m.Called(query, page)
 	return args.Get(0).([]model.Deck), args.Error(1)
 }
-func (m *MockDeckRepository) SearchUserPublicDecks(userId uuid.UUID) ([]model.Deck, error) {
-	args := m.Called(userId)
+func (m *MockDeckRepository) SearchUserPublicDecks(userId uuid.UUID, query string, page pagination.Page) ([]model.Deck, error) {
+	args := m.Called(userId, query, page)
 	return args.Get(0).([]model.Deck), args.Error(1)
 }
 
@@ -56,14 +76,103 @@ func (m *MockDeckRepository) AddCardToDeck(cardId uuid.UUID, deckId uuid.UUID) e
 	return args.Error(0)
 }
 
-func (m *MockDeckRepository) FindAllCardsInDeck(deckId uuid.UUID) ([]modelCard.Card, error) {
+func (m *MockDeckRepository) FindAllCardsInDeck(deckId uuid.UUID, page pagination.Page) ([]modelCard.Card, error) {
+	args := m.Called(deckId, page)
+	return args.Get(0).([]modelCard.Card), args.Error(1)
+}
+
+func (m *MockDeckRepository) ReadOptions(deckId uuid.UUID) (*model.Options, error) {
+	args := m.Called(deckId)
+	return args.Get(0).(*model.Options), args.Error(1)
+}
+
+func (m *MockDeckRepository) UpsertOptions(options *model.Options) error {
+	args := m.Called(options)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) RemoveDeck(deckId uuid.UUID) error {
 	args := m.Called(deckId)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) SetSubscribed(deckId uuid.UUID, subscribed bool) error {
+	args := m.Called(deckId, subscribed)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) ReadMember(deckId uuid.UUID, userId uuid.UUID) (*model.Member, error) {
+	args := m.Called(deckId, userId)
+	return args.Get(0).(*model.Member), args.Error(1)
+}
+
+func (m *MockDeckRepository) ReadMembers(deckId uuid.UUID) ([]model.Member, error) {
+	args := m.Called(deckId)
+	return args.Get(0).([]model.Member), args.Error(1)
+}
+
+func (m *MockDeckRepository) UpsertMember(member *model.Member) error {
+	args := m.Called(member)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) DeleteMember(deckId uuid.UUID, userId uuid.UUID) error {
+	args := m.Called(deckId, userId)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) UpdateDeck(deck *model.Deck, visibilityChanged bool) error {
+	args := m.Called(deck, visibilityChanged)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) ReadCards(cardIds []uuid.UUID) ([]modelCard.Card, error) {
+	args := m.Called(cardIds)
 	return args.Get(0).([]modelCard.Card), args.Error(1)
 }
 
+func (m *MockDeckRepository) MoveCards(cardIds []uuid.UUID, target *model.Deck) (int64, error) {
+	args := m.Called(cardIds, target)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDeckRepository) RemoveCardFromDeck(card *modelCard.Card, deckId uuid.UUID) error {
+	args := m.Called(card, deckId)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) ReadSubtree(deckId uuid.UUID) ([]model.Deck, error) {
+	args := m.Called(deckId)
+	return args.Get(0).([]model.Deck), args.Error(1)
+}
+
+func (m *MockDeckRepository) SetParent(deckId uuid.UUID, parentDeckId *uuid.UUID) error {
+	args := m.Called(deckId, parentDeckId)
+	return args.Error(0)
+}
+
+func (m *MockDeckRepository) CountDueCards(deckIds []uuid.UUID, userId uuid.UUID) ([]model.DueCount, error) {
+	args := m.Called(deckIds, userId)
+	return args.Get(0).([]model.DueCount), args.Error(1)
+}
+
+type MockCardClient struct {
+	mock.Mock
+}
+
+func (m *MockCardClient) ReleaseDeckCards(ctx context.Context, deckId uuid.UUID, mode string, targetDeckId uuid.UUID, deletedAt time.Time) (int, error) {
+	args := m.Called(deckId, mode, targetDeckId, deletedAt)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCardClient) CloneDeckCards(ctx context.Context, sourceDeckId uuid.UUID, deckId uuid.UUID) (int, error) {
+	args := m.Called(sourceDeckId, deckId)
+	return args.Int(0), args.Error(1)
+}
+
 func TestAddDeck(t *testing.T) {
 	mockRepo := new(MockDeckRepository)
-	service := services.New(nil, mockRepo)
+	service := services.New(nil, mockRepo, new(MockCardClient))
 
 	deck := &model.Deck{
 		DeckId:    uuid.New(),
@@ -82,7 +191,7 @@ func TestAddDeck(t *testing.T) {
 
 func TestReadDeck_Authorized(t *testing.T) {
 	mockRepo := new(MockDeckRepository)
-	service := services.New(nil, mockRepo)
+	service := services.New(nil, mockRepo, new(MockCardClient))
 
 	userId := uuid.New()
 	deck := &model.Deck{
@@ -100,7 +209,7 @@ func TestReadDeck_Authorized(t *testing.T) {
 
 func TestReadDeck_Unauthorized(t *testing.T) {
 	mockRepo := new(MockDeckRepository)
-	service := services.New(nil, mockRepo)
+	service := services.New(nil, mockRepo, new(MockCardClient))
 
 	ownerId := uuid.New()
 	requesterId := uuid.New()
@@ -111,6 +220,7 @@ func TestReadDeck_Unauthorized(t *testing.T) {
 	}
 
 	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("ReadMember", deck.DeckId, requesterId).Return(&model.Member{}, nil)
 
 	result, err := service.ReadDeck(deck.DeckId, requesterId)
 	assert.Error(t, err)
@@ -118,9 +228,35 @@ func TestReadDeck_Unauthorized(t *testing.T) {
 	assert.Equal(t, services.ErrUnauthorized, err)
 }
 
+func TestReadAllCardsFromDeck_Roles(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	ownerId := uuid.New()
+	viewerId := uuid.New()
+	strangerId := uuid.New()
+	deck := &model.Deck{DeckId: uuid.New(), Name: "Private Deck", CreatedBy: ownerId}
+	page := pagination.Page{Limit: pagination.DefaultLimit}
+	cards := []modelCard.Card{{CardId: uuid.New(), DeckID: deck.DeckId}}
+
+	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("ReadMember", deck.DeckId, viewerId).Return(&model.Member{DeckId: deck.DeckId, UserId: viewerId, Role: model.RoleViewer}, nil)
+	mockRepo.On("ReadMember", deck.DeckId, strangerId).Return(&model.Member{}, nil)
+	mockRepo.On("FindAllCardsInDeck", deck.DeckId, page).Return(cards, nil).Once()
+
+	result, _, err := service.ReadAllCardsFromDeck(deck.DeckId, viewerId, page)
+	assert.NoError(t, err)
+	assert.Equal(t, cards, result)
+
+	_, _, err = service.ReadAllCardsFromDeck(deck.DeckId, strangerId, page)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestDeleteDeck_Success(t *testing.T) {
 	mockRepo := new(MockDeckRepository)
-	service := services.New(nil, mockRepo)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
 
 	userId := uuid.New()
 	deckId := uuid.New()
@@ -129,16 +265,166 @@ func TestDeleteDeck_Success(t *testing.T) {
 		CreatedBy: userId,
 	}
 
+	var deletedAt time.Time
 	mockRepo.On("ReadDeck", deckId).Return(deck, nil)
-	mockRepo.On("DeleteDeck", deckId).Return(nil)
+	mockCards.On("ReleaseDeckCards", deckId, model.DeleteModeCards, uuid.Nil, mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { deletedAt = args.Get(3).(time.Time) }).
+		Return(2, nil)
+	mockRepo.On("DeleteDeck", deckId, (*uuid.UUID)(nil), mock.MatchedBy(func(at time.Time) bool { return at.Equal(deletedAt) })).Return(nil)
 
-	err := service.DeleteDeck(deckId, userId)
+	err := service.DeleteDeck(context.Background(), deckId, userId, "", uuid.Nil)
 	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockCards.AssertExpectations(t)
+}
+
+func TestDeleteDeck_Subdecks(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
+
+	userId := uuid.New()
+	rootId := uuid.New()
+	deck := &model.Deck{DeckId: uuid.New(), CreatedBy: userId, ParentDeckId: &rootId}
+
+	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockCards.On("ReleaseDeckCards", deck.DeckId, model.DeleteModeCards, uuid.Nil, mock.AnythingOfType("time.Time")).Return(0, nil)
+	// Subdecks move up to the root instead of pointing at a deck in trash
+	mockRepo.On("DeleteDeck", deck.DeckId, &rootId, mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	err := service.DeleteDeck(context.Background(), deck.DeckId, userId, "", uuid.Nil)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockCards.AssertExpectations(t)
+}
+
+func TestDeleteDeck_MoveCards(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
+
+	userId := uuid.New()
+	deck := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	target := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+
+	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("ReadDeck", target.DeckId).Return(target, nil)
+	mockCards.On("ReleaseDeckCards", deck.DeckId, model.DeleteModeMove, target.DeckId, mock.Anything).Return(5, nil)
+	mockRepo.On("DeleteDeck", deck.DeckId, (*uuid.UUID)(nil), mock.Anything).Return(nil)
+
+	err := service.DeleteDeck(context.Background(), deck.DeckId, userId, model.DeleteModeMove, target.DeckId)
+	assert.NoError(t, err)
+	mockCards.AssertExpectations(t)
+}
+
+func TestDeleteDeck_InvalidMove(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
+
+	userId := uuid.New()
+	deck := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	foreign := &model.Deck{DeckId: uuid.New(), CreatedBy: uuid.New()}
+
+	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("ReadDeck", foreign.DeckId).Return(foreign, nil)
+	mockRepo.On("ReadMember", foreign.DeckId, userId).Return(&model.Member{}, nil)
+
+	err := service.DeleteDeck(context.Background(), deck.DeckId, userId, model.DeleteModeMove, foreign.DeckId)
+	assert.Equal(t, services.ErrInvalidTargetDeck, err)
+
+	err = service.DeleteDeck(context.Background(), deck.DeckId, userId, model.DeleteModeMove, uuid.Nil)
+	assert.Equal(t, services.ErrInvalidTargetDeck, err)
+
+	err = service.DeleteDeck(context.Background(), deck.DeckId, userId, "archive", uuid.Nil)
+	assert.Equal(t, services.ErrInvalidDeleteMode, err)
+
+	mockCards.AssertNotCalled(t, "ReleaseDeckCards", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "DeleteDeck", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReadAllDecksOfUser_Paged(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	now := time.Now()
+	decks := []model.Deck{
+		{DeckId: uuid.New(), CreatedBy: userId, CreatedAt: now},
+		{DeckId: uuid.New(), CreatedBy: userId, CreatedAt: now.Add(-time.Hour)},
+	}
+	page := pagination.Page{Limit: 1}
+	mockRepo.On("ReadAllDecksOfUser", userId, page).Return(decks, nil)
+
+	result, next, err := service.ReadAllDecksOfUser(userId, page)
+	assert.NoError(t, err)
+	assert.Equal(t, decks[:1], result)
+
+	cursor, err := pagination.Decode(next)
+	assert.NoError(t, err)
+	assert.Equal(t, decks[0].DeckId, cursor.Id)
+}
+
+func TestSearchUserPublicDecks_Ranked(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	now := time.Now()
+	decks := []model.Deck{
+		{DeckId: uuid.New(), CreatedBy: userId, CreatedAt: now.Add(-time.Hour), Name: "Verbs", Rank: 0.6},
+		{DeckId: uuid.New(), CreatedBy: userId, CreatedAt: now, Name: "Irregular verbs", Rank: 0.4},
+	}
+	page := pagination.Page{Limit: 1}
+	mockRepo.On("SearchUserPublicDecks", userId, "verb", page).Return(decks, nil)
+
+	result, next, err := service.SearchUserPublicDecks(userId.String(), " verb", page)
+	assert.NoError(t, err)
+	assert.Equal(t, decks[:1], result)
+
+	cursor, err := pagination.Decode(next)
+	assert.NoError(t, err)
+	assert.Equal(t, decks[0].DeckId, cursor.Id)
+	assert.Equal(t, float32(0.6), cursor.Rank)
+}
+
+func TestRestoreDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	deletedAt := time.Now().Add(-time.Hour)
+	deck := &model.Deck{
+		DeckId:    uuid.New(),
+		CreatedBy: userId,
+		DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true},
+	}
+
+	mockRepo.On("ReadTrashedDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("RestoreDeck", deck.DeckId, deletedAt).Return(nil)
+
+	result, err := service.RestoreDeck(deck.DeckId, userId)
+	assert.NoError(t, err)
+	assert.False(t, result.DeletedAt.Valid)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRestoreDeck_NotInTrash(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	deckId := uuid.New()
+	mockRepo.On("ReadTrashedDeck", deckId).Return(&model.Deck{}, nil)
+
+	result, err := service.RestoreDeck(deckId, uuid.New())
+	assert.Nil(t, result)
+	assert.Equal(t, services.ErrDeckNotFound, err)
+	mockRepo.AssertNotCalled(t, "RestoreDeck", mock.Anything, mock.Anything)
 }
 
 func TestAddCardToDeck_Unauthorized(t *testing.T) {
 	mockRepo := new(MockDeckRepository)
-	service := services.New(nil, mockRepo)
+	service := services.New(nil, mockRepo, new(MockCardClient))
 
 	ownerId := uuid.New()
 	requesterId := uuid.New()
@@ -151,8 +437,503 @@ func TestAddCardToDeck_Unauthorized(t *testing.T) {
 	}
 
 	mockRepo.On("ReadDeck", deckId).Return(deck, nil)
+	mockRepo.On("ReadMember", deckId, requesterId).Return(&model.Member{DeckId: deckId, UserId: requesterId, Role: model.RoleViewer}, nil)
 
 	err := service.AddCardToDeck(cardId, deckId, requesterId)
 	assert.Error(t, err)
 	assert.Equal(t, services.ErrUnauthorized, err)
 }
+
+func TestReadOptions_Defaults(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	deckId := uuid.New()
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: userId}, nil)
+	mockRepo.On("ReadOptions", deckId).Return(&model.Options{}, nil)
+
+	options, err := service.ReadOptions(deckId, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, model.DefaultOptions(deckId), *options)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateOptions(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	deckId := uuid.New()
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: userId}, nil)
+	mockRepo.On("UpsertOptions", mock.AnythingOfType("*model.Options")).Return(nil)
+
+	options, err := service.UpdateOptions(deckId, userId, &model.Options{LearningSteps: []int64{5, 30}})
+	assert.NoError(t, err)
+	assert.Equal(t, deckId, options.DeckId)
+	assert.Equal(t, model.DefaultGraduatingInterval, options.GraduatingInterval)
+	assert.Equal(t, model.DefaultMaximumInterval, options.MaximumInterval)
+
+	assert.Equal(t, model.NewCardOrderAdded, options.NewCardOrder)
+
+	_, err = service.UpdateOptions(deckId, userId, &model.Options{GraduatingInterval: 10, EasyInterval: 2})
+	assert.ErrorIs(t, err, services.ErrInvalidOptions)
+
+	_, err = service.UpdateOptions(deckId, userId, &model.Options{Algorithm: "leitner"})
+	assert.ErrorIs(t, err, services.ErrInvalidOptions)
+
+	_, err = service.UpdateOptions(deckId, userId, &model.Options{NewPerDay: -1})
+	assert.ErrorIs(t, err, services.ErrInvalidOptions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCloneDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
+
+	userId := uuid.New()
+	source := &model.Deck{DeckId: uuid.New(), CreatedBy: uuid.New(), Name: "German A1", IsPublic: true}
+	cloneId := uuid.New()
+
+	mockRepo.On("ReadDeck", source.DeckId).Return(source, nil)
+	mockRepo.On("AddDeck", mock.MatchedBy(func(deck *model.Deck) bool {
+		return deck.CreatedBy == userId && deck.Name == "German A1" && !deck.IsPublic && *deck.SourceDeckId == source.DeckId
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*model.Deck).DeckId = cloneId
+	}).Return(nil)
+	mockRepo.On("ReadOptions", source.DeckId).Return(&model.Options{DeckId: source.DeckId, NewPerDay: 5}, nil)
+	mockRepo.On("UpsertOptions", mock.MatchedBy(func(options *model.Options) bool {
+		return options.DeckId == cloneId && options.NewPerDay == 5
+	})).Return(nil)
+	mockCards.On("CloneDeckCards", source.DeckId, cloneId).Return(3, nil)
+
+	clone, err := service.CloneDeck(context.Background(), source.DeckId, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, cloneId, clone.DeckId)
+	assert.Equal(t, uint(3), clone.CardsQuantity)
+	mockRepo.AssertExpectations(t)
+	mockCards.AssertExpectations(t)
+}
+
+func TestCloneDeck_Errors(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
+
+	userId := uuid.New()
+	private := &model.Deck{DeckId: uuid.New(), CreatedBy: uuid.New()}
+	own := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	cloneId := uuid.New()
+
+	mockRepo.On("ReadDeck", private.DeckId).Return(private, nil)
+	mockRepo.On("ReadMember", private.DeckId, userId).Return(&model.Member{}, nil)
+	_, err := service.CloneDeck(context.Background(), private.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	// A failed copy of cards removes the new deck
+	mockRepo.On("ReadDeck", own.DeckId).Return(own, nil)
+	mockRepo.On("AddDeck", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*model.Deck).DeckId = cloneId
+	}).Return(nil)
+	mockRepo.On("ReadOptions", own.DeckId).Return(&model.Options{}, nil)
+	mockCards.On("CloneDeckCards", own.DeckId, cloneId).Return(0, errors.New("card service is down"))
+	mockRepo.On("RemoveDeck", cloneId).Return(nil).Once()
+
+	_, err = service.CloneDeck(context.Background(), own.DeckId, userId)
+	assert.Error(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpsertOptions", mock.Anything)
+}
+
+func TestSubscribeDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	mockCards := new(MockCardClient)
+	service := services.New(nil, mockRepo, mockCards)
+
+	userId := uuid.New()
+	source := &model.Deck{DeckId: uuid.New(), CreatedBy: uuid.New(), Name: "German A1", IsPublic: true, Version: 4}
+	own := &model.Deck{DeckId: uuid.New(), CreatedBy: userId, IsPublic: true}
+	cloneId := uuid.New()
+
+	mockRepo.On("ReadDeck", source.DeckId).Return(source, nil)
+	mockRepo.On("ReadDeck", own.DeckId).Return(own, nil)
+	mockRepo.On("AddDeck", mock.MatchedBy(func(deck *model.Deck) bool {
+		return deck.Subscribed && deck.SyncedVersion == 4 && *deck.SourceDeckId == source.DeckId
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*model.Deck).DeckId = cloneId
+	}).Return(nil).Once()
+	mockRepo.On("ReadOptions", source.DeckId).Return(&model.Options{}, nil)
+	mockCards.On("CloneDeckCards", source.DeckId, cloneId).Return(2, nil)
+
+	deck, err := service.SubscribeDeck(context.Background(), source.DeckId, userId)
+	assert.NoError(t, err)
+	assert.True(t, deck.Subscribed)
+
+	_, err = service.SubscribeDeck(context.Background(), own.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrOwnDeck)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUnsubscribeDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	sourceId := uuid.New()
+	subscribed := &model.Deck{DeckId: uuid.New(), CreatedBy: userId, SourceDeckId: &sourceId, Subscribed: true}
+	plain := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+
+	mockRepo.On("ReadDeck", subscribed.DeckId).Return(subscribed, nil)
+	mockRepo.On("ReadDeck", plain.DeckId).Return(plain, nil)
+	mockRepo.On("SetSubscribed", subscribed.DeckId, false).Return(nil).Once()
+
+	deck, err := service.UnsubscribeDeck(subscribed.DeckId, userId)
+	assert.NoError(t, err)
+	assert.False(t, deck.Subscribed)
+
+	_, err = service.UnsubscribeDeck(plain.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrNotSubscribed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeckRoles(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	authorId, viewerId, editorId := uuid.New(), uuid.New(), uuid.New()
+	deckId := uuid.New()
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: authorId}, nil)
+	mockRepo.On("ReadMember", deckId, viewerId).Return(&model.Member{DeckId: deckId, UserId: viewerId, Role: model.RoleViewer}, nil)
+	mockRepo.On("ReadMember", deckId, editorId).Return(&model.Member{DeckId: deckId, UserId: editorId, Role: model.RoleEditor}, nil)
+	cardId := uuid.New()
+	mockRepo.On("ReadCards", []uuid.UUID{cardId}).Return([]modelCard.Card{{CardId: cardId, CreatedBy: editorId}}, nil)
+	mockRepo.On("AddCardToDeck", cardId, deckId).Return(nil).Once()
+
+	deck, err := service.ReadDeck(deckId, viewerId)
+	assert.NoError(t, err)
+	assert.Equal(t, model.RoleViewer, deck.Role)
+
+	err = service.AddCardToDeck(uuid.New(), deckId, viewerId)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	err = service.AddCardToDeck(cardId, deckId, editorId)
+	assert.NoError(t, err)
+
+	_, err = service.UpdateOptions(deckId, editorId, &model.Options{})
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	err = service.DeleteDeck(context.Background(), deckId, editorId, model.DeleteModeCards, uuid.Nil)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestInviteMember(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	authorId, memberId := uuid.New(), uuid.New()
+	deck := &model.Deck{DeckId: uuid.New(), CreatedBy: authorId}
+	joinedAt := time.Now().Add(-time.Hour)
+
+	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("ReadMember", deck.DeckId, memberId).Return(&model.Member{
+		DeckId: deck.DeckId, UserId: memberId, Role: model.RoleViewer, CreatedAt: joinedAt,
+	}, nil)
+	mockRepo.On("UpsertMember", mock.MatchedBy(func(member *model.Member) bool {
+		return member.Role == model.RoleEditor && member.InvitedBy == authorId && member.CreatedAt.Equal(joinedAt)
+	})).Return(nil).Once()
+
+	// Inviting a member again changes the role
+	member, err := service.InviteMember(deck.DeckId, authorId, memberId, model.RoleEditor)
+	assert.NoError(t, err)
+	assert.Equal(t, model.RoleEditor, member.Role)
+
+	_, err = service.InviteMember(deck.DeckId, authorId, memberId, "admin")
+	assert.ErrorIs(t, err, services.ErrInvalidRole)
+
+	_, err = service.InviteMember(deck.DeckId, authorId, authorId, model.RoleViewer)
+	assert.ErrorIs(t, err, services.ErrDeckAuthor)
+
+	// Only owners manage members
+	_, err = service.InviteMember(deck.DeckId, memberId, uuid.New(), model.RoleViewer)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRemoveMember(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	authorId, viewerId, editorId, strangerId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	deck := &model.Deck{DeckId: uuid.New(), CreatedBy: authorId}
+
+	mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	mockRepo.On("ReadMember", deck.DeckId, viewerId).Return(&model.Member{DeckId: deck.DeckId, UserId: viewerId, Role: model.RoleViewer}, nil)
+	mockRepo.On("ReadMember", deck.DeckId, editorId).Return(&model.Member{DeckId: deck.DeckId, UserId: editorId, Role: model.RoleEditor}, nil)
+	mockRepo.On("ReadMember", deck.DeckId, strangerId).Return(&model.Member{}, nil)
+	mockRepo.On("DeleteMember", deck.DeckId, viewerId).Return(nil).Once()
+	mockRepo.On("DeleteMember", deck.DeckId, editorId).Return(nil).Once()
+
+	// Members leave on their own, removing others is left to owners
+	err := service.RemoveMember(deck.DeckId, editorId, viewerId)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	err = service.RemoveMember(deck.DeckId, viewerId, viewerId)
+	assert.NoError(t, err)
+
+	err = service.RemoveMember(deck.DeckId, authorId, editorId)
+	assert.NoError(t, err)
+
+	err = service.RemoveMember(deck.DeckId, authorId, strangerId)
+	assert.ErrorIs(t, err, services.ErrMemberNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	deckId := uuid.New()
+	name, public := "  Verbs  ", true
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: userId, Name: "Nouns", Description: "A1"}, nil).Once()
+	mockRepo.On("UpdateDeck", mock.MatchedBy(func(deck *model.Deck) bool {
+		return deck.Name == "Verbs" && deck.Description == "A1" && deck.IsPublic
+	}), true).Return(nil).Once()
+
+	// Fields left out keep their values, visibility goes down to the cards
+	deck, err := service.UpdateDeck(deckId, userId, &schemes.UpdateDeckScheme{Name: &name, IsPublic: &public})
+	assert.NoError(t, err)
+	assert.Equal(t, "Verbs", deck.Name)
+	assert.Equal(t, "A1", deck.Description)
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: userId, Name: "Verbs", IsPublic: true}, nil).Once()
+	mockRepo.On("UpdateDeck", mock.Anything, false).Return(nil).Once()
+
+	_, err = service.UpdateDeck(deckId, userId, &schemes.UpdateDeckScheme{IsPublic: &public})
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateDeck_Errors(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId, editorId := uuid.New(), uuid.New()
+	deckId, missing := uuid.New(), uuid.New()
+	blank := " "
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: userId, Name: "Nouns"}, nil)
+	mockRepo.On("ReadDeck", missing).Return((*model.Deck)(nil), gorm.ErrRecordNotFound)
+	mockRepo.On("ReadMember", deckId, editorId).Return(&model.Member{DeckId: deckId, UserId: editorId, Role: model.RoleEditor}, nil)
+
+	_, err := service.UpdateDeck(deckId, userId, &schemes.UpdateDeckScheme{Name: &blank})
+	assert.ErrorIs(t, err, services.ErrInvalidDeck)
+
+	_, err = service.UpdateDeck(missing, userId, &schemes.UpdateDeckScheme{})
+	assert.ErrorIs(t, err, services.ErrDeckNotFound)
+
+	_, err = service.UpdateDeck(deckId, editorId, &schemes.UpdateDeckScheme{})
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	mockRepo.AssertNotCalled(t, "UpdateDeck", mock.Anything, mock.Anything)
+}
+
+func TestMoveCards(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId, editorId := uuid.New(), uuid.New()
+	target := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	shared := &model.Deck{DeckId: uuid.New(), CreatedBy: uuid.New()}
+	own, other := uuid.New(), uuid.New()
+
+	mockRepo.On("ReadDeck", target.DeckId).Return(target, nil)
+	mockRepo.On("ReadDeck", shared.DeckId).Return(shared, nil)
+	mockRepo.On("ReadMember", shared.DeckId, userId).Return(&model.Member{DeckId: shared.DeckId, UserId: userId, Role: model.RoleEditor}, nil)
+	mockRepo.On("ReadMember", target.DeckId, editorId).Return(&model.Member{}, nil)
+	mockRepo.On("ReadCards", []uuid.UUID{own, other}).Return([]modelCard.Card{
+		{CardId: own, CreatedBy: userId},
+		{CardId: other, CreatedBy: shared.CreatedBy, DeckID: shared.DeckId},
+	}, nil)
+	mockRepo.On("MoveCards", []uuid.UUID{own, other}, target).Return(int64(2), nil).Once()
+
+	// Cards of other authors move out of decks the user edits
+	moved, err := service.MoveCards([]uuid.UUID{own, other}, target.DeckId, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, moved)
+
+	_, err = service.MoveCards(nil, target.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrNoCardsSelected)
+
+	_, err = service.MoveCards(make([]uuid.UUID, services.MaxMoveCards+1), target.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrTooManyCards)
+
+	_, err = service.MoveCards([]uuid.UUID{own, other}, target.DeckId, editorId)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMoveCards_Errors(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	target := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	private := &model.Deck{DeckId: uuid.New(), CreatedBy: uuid.New()}
+	foreign, missing := uuid.New(), uuid.New()
+
+	mockRepo.On("ReadDeck", target.DeckId).Return(target, nil)
+	mockRepo.On("ReadDeck", private.DeckId).Return(private, nil)
+	mockRepo.On("ReadMember", private.DeckId, userId).Return(&model.Member{}, nil)
+	mockRepo.On("ReadCards", []uuid.UUID{foreign}).Return([]modelCard.Card{
+		{CardId: foreign, CreatedBy: private.CreatedBy, DeckID: private.DeckId},
+	}, nil)
+	mockRepo.On("ReadCards", []uuid.UUID{missing}).Return([]modelCard.Card{}, nil)
+
+	_, err := service.MoveCards([]uuid.UUID{foreign}, target.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrUnauthorized)
+
+	_, err = service.MoveCards([]uuid.UUID{missing}, target.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrCardNotFound)
+
+	mockRepo.AssertNotCalled(t, "MoveCards", mock.Anything, mock.Anything)
+}
+
+func TestRemoveCardFromDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	deckId := uuid.New()
+	inDeck, elsewhere, missing := uuid.New(), uuid.New(), uuid.New()
+
+	mockRepo.On("ReadDeck", deckId).Return(&model.Deck{DeckId: deckId, CreatedBy: userId, IsPublic: true}, nil)
+	mockRepo.On("ReadCards", []uuid.UUID{inDeck}).Return([]modelCard.Card{{CardId: inDeck, DeckID: deckId, IsPublic: true}}, nil)
+	mockRepo.On("ReadCards", []uuid.UUID{elsewhere}).Return([]modelCard.Card{{CardId: elsewhere, DeckID: uuid.New()}}, nil)
+	mockRepo.On("ReadCards", []uuid.UUID{missing}).Return([]modelCard.Card{}, nil)
+	// A card removed from a public deck is no longer searchable
+	mockRepo.On("RemoveCardFromDeck", mock.MatchedBy(func(card *modelCard.Card) bool {
+		return card.CardId == inDeck && card.DeckID == uuid.Nil && !card.IsPublic
+	}), deckId).Return(nil).Once()
+
+	err := service.RemoveCardFromDeck(inDeck, deckId, userId)
+	assert.NoError(t, err)
+
+	err = service.RemoveCardFromDeck(elsewhere, deckId, userId)
+	assert.ErrorIs(t, err, services.ErrCardNotInDeck)
+
+	err = service.RemoveCardFromDeck(missing, deckId, userId)
+	assert.ErrorIs(t, err, services.ErrCardNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMoveDeck(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId, otherId := uuid.New(), uuid.New()
+	language := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	level := &model.Deck{DeckId: uuid.New(), CreatedBy: userId, ParentDeckId: &language.DeckId}
+	unit := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	foreign := &model.Deck{DeckId: uuid.New(), CreatedBy: otherId}
+
+	for _, deck := range []*model.Deck{language, level, unit, foreign} {
+		mockRepo.On("ReadDeck", deck.DeckId).Return(deck, nil)
+	}
+	mockRepo.On("ReadMember", foreign.DeckId, userId).Return(&model.Member{DeckId: foreign.DeckId, UserId: userId, Role: model.RoleOwner}, nil)
+	mockRepo.On("ReadSubtree", unit.DeckId).Return([]model.Deck{*unit}, nil)
+	mockRepo.On("ReadSubtree", language.DeckId).Return([]model.Deck{*language, *level}, nil)
+	mockRepo.On("SetParent", unit.DeckId, &level.DeckId).Return(nil).Once()
+	mockRepo.On("SetParent", unit.DeckId, (*uuid.UUID)(nil)).Return(nil).Once()
+
+	deck, err := service.MoveDeck(unit.DeckId, level.DeckId, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, level.DeckId, *deck.ParentDeckId)
+
+	deck, err = service.MoveDeck(unit.DeckId, uuid.Nil, userId)
+	assert.NoError(t, err)
+	assert.Nil(t, deck.ParentDeckId)
+
+	// A deck can't go under its own subdeck
+	_, err = service.MoveDeck(language.DeckId, level.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrDeckCycle)
+
+	// Owning a deck of another author is not enough to nest under it
+	_, err = service.MoveDeck(unit.DeckId, foreign.DeckId, userId)
+	assert.ErrorIs(t, err, services.ErrInvalidParent)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAddDeck_Parent(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId := uuid.New()
+	parent := &model.Deck{DeckId: uuid.New(), CreatedBy: userId}
+	missing := uuid.New()
+
+	mockRepo.On("ReadDeck", parent.DeckId).Return(parent, nil)
+	mockRepo.On("ReadDeck", missing).Return((*model.Deck)(nil), gorm.ErrRecordNotFound)
+	mockRepo.On("AddDeck", mock.AnythingOfType("*model.Deck")).Return(nil).Once()
+
+	deck, err := service.AddDeck(&model.Deck{CreatedBy: userId, Name: "A1", ParentDeckId: &parent.DeckId})
+	assert.NoError(t, err)
+	assert.Equal(t, parent.DeckId, *deck.ParentDeckId)
+
+	_, err = service.AddDeck(&model.Deck{CreatedBy: userId, Name: "A2", ParentDeckId: &missing})
+	assert.ErrorIs(t, err, services.ErrInvalidParent)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReadDueCounts(t *testing.T) {
+	mockRepo := new(MockDeckRepository)
+	service := services.New(nil, mockRepo, new(MockCardClient))
+
+	userId, authorId := uuid.New(), uuid.New()
+	language := model.Deck{DeckId: uuid.New(), CreatedBy: authorId, Name: "Spanish"}
+	a1 := model.Deck{DeckId: uuid.New(), CreatedBy: authorId, Name: "A1", ParentDeckId: &language.DeckId}
+	unit := model.Deck{DeckId: uuid.New(), CreatedBy: authorId, Name: "Unit 1", ParentDeckId: &a1.DeckId}
+	hidden := model.Deck{DeckId: uuid.New(), CreatedBy: authorId, Name: "A2", ParentDeckId: &language.DeckId}
+	below := model.Deck{DeckId: uuid.New(), CreatedBy: authorId, Name: "Unit 2", ParentDeckId: &hidden.DeckId}
+
+	mockRepo.On("ReadDeck", language.DeckId).Return(&language, nil)
+	mockRepo.On("ReadSubtree", language.DeckId).Return([]model.Deck{language, a1, unit, hidden, below}, nil)
+	for _, deck := range []model.Deck{language, a1, unit, below} {
+		mockRepo.On("ReadMember", deck.DeckId, userId).Return(&model.Member{DeckId: deck.DeckId, UserId: userId, Role: model.RoleViewer}, nil)
+	}
+	mockRepo.On("ReadMember", hidden.DeckId, userId).Return(&model.Member{}, nil)
+	mockRepo.On("CountDueCards", []uuid.UUID{language.DeckId, a1.DeckId, unit.DeckId}, userId).Return([]model.DueCount{
+		{DeckId: language.DeckId, New: 1},
+		{DeckId: unit.DeckId, New: 2, Learning: 3, Review: 4},
+	}, nil)
+
+	// Subdecks the user can't read are left out with everything below them
+	tree, err := service.ReadDeckTree(language.DeckId, userId)
+	assert.NoError(t, err)
+	assert.Len(t, tree, 3)
+
+	counts, err := service.ReadDueCounts(language.DeckId, userId)
+	assert.NoError(t, err)
+	assert.Len(t, counts, 3)
+
+	assert.Equal(t, language.DeckId, counts[0].DeckId)
+	assert.Equal(t, 1, counts[0].New)
+	assert.Equal(t, 3, counts[0].TotalNew)
+	assert.Equal(t, 3, counts[0].TotalLearning)
+	assert.Equal(t, 4, counts[0].TotalReview)
+
+	assert.Equal(t, a1.DeckId, counts[1].DeckId)
+	assert.Equal(t, 0, counts[1].New)
+	assert.Equal(t, 2, counts[1].TotalNew)
+
+	assert.Equal(t, unit.DeckId, counts[2].DeckId)
+	assert.Equal(t, 4, counts[2].Review)
+	assert.Equal(t, 4, counts[2].TotalReview)
+	mockRepo.AssertExpectations(t)
+}
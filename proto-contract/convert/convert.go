@@ -0,0 +1,803 @@
+package convert
+
+import (
+	"fmt"
+	"time"
+
+	cardv1 "github.com/GOeda-Co/proto-contract/gen/go/card"
+	deckv1 "github.com/GOeda-Co/proto-contract/gen/go/deck"
+	"github.com/google/uuid"
+
+	model "github.com/GOeda-Co/proto-contract/model/card"
+	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func fromProtoTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func fromProtoDeletedAt(ts *timestamppb.Timestamp) gorm.DeletedAt {
+	if ts == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: ts.AsTime(), Valid: true}
+}
+
+func toProtoDeletedAt(deletedAt gorm.DeletedAt) *timestamppb.Timestamp {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return timestamppb.New(deletedAt.Time)
+}
+
+// fromProtoOptionalId parses an id that may be empty
+func fromProtoOptionalId(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func toProtoOptionalId(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func FromProtoToModelCard(card *cardv1.Card) (*model.Card, error) {
+	cardId, err := uuid.Parse(card.CardId)
+	if err != nil {
+		return nil, fmt.Errorf("cardId is invalid: %w", err)
+	}
+	createdBy, err := uuid.Parse(card.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("createdBy is invalid: %w", err)
+	}
+	deckId, err := uuid.Parse(card.DeckId)
+	if err != nil {
+		return nil, fmt.Errorf("DeckId is invalid: %w", err)
+	}
+	sourceCardId, err := fromProtoOptionalId(card.SourceCardId)
+	if err != nil {
+		return nil, fmt.Errorf("sourceCardId is invalid: %w", err)
+	}
+	noteId, err := fromProtoOptionalId(card.NoteId)
+	if err != nil {
+		return nil, fmt.Errorf("noteId is invalid: %w", err)
+	}
+	var siblings []model.Card
+	for _, sibling := range card.Siblings {
+		converted, err := FromProtoToModelCard(sibling)
+		if err != nil {
+			return nil, err
+		}
+		siblings = append(siblings, *converted)
+	}
+
+	return &model.Card{
+		CardId:           cardId,
+		CreatedBy:        createdBy,
+		CreatedAt:        card.CreatedAt.AsTime(),
+		Word:             card.Word,
+		Translation:      card.Translation,
+		Easiness:         card.Easiness,
+		UpdatedAt:        card.UpdatedAt.AsTime(),
+		Interval:         int(card.Interval),
+		ExpiresAt:        card.ExpiresAt.AsTime(),
+		RepetitionNumber: int(card.RepetitionNumber),
+		DeckID:           deckId,
+		Tags:             card.Tags,
+		IsPublic:         card.IsPublic,
+		Stability:        card.Stability,
+		Difficulty:       card.Difficulty,
+		Lapses:           int(card.Lapses),
+		LastReviewedAt:   fromProtoTimestamp(card.LastReviewedAt),
+		Algorithm:        card.Algorithm,
+		Phase:            card.Phase,
+		Step:             int(card.Step),
+		DeletedAt:        fromProtoDeletedAt(card.DeletedAt),
+		SourceCardId:     sourceCardId,
+		KeepLocal:        card.KeepLocal,
+		Template:         card.Template,
+		NoteId:           noteId,
+		BuriedUntil:      fromProtoTimestamp(card.BuriedUntil),
+		Ordinal:          int(card.Ordinal),
+		Siblings:         siblings,
+		Front:            card.Front,
+		Back:             card.Back,
+
+		Rank:                 card.Rank,
+		WordHighlight:        card.WordHighlight,
+		TranslationHighlight: card.TranslationHighlight,
+	}, nil
+}
+
+func FromModelToProtoCard(card *model.Card) *cardv1.Card {
+	var siblings []*cardv1.Card
+	for _, sibling := range card.Siblings {
+		siblings = append(siblings, FromModelToProtoCard(&sibling))
+	}
+	front, back := card.Sides()
+	return &cardv1.Card{
+		CardId:           card.CardId.String(),
+		CreatedBy:        card.CreatedBy.String(),
+		CreatedAt:        timestamppb.New(card.CreatedAt),
+		Word:             card.Word,
+		Translation:      card.Translation,
+		Easiness:         card.Easiness,
+		UpdatedAt:        timestamppb.New(card.UpdatedAt),
+		Interval:         int32(card.Interval),
+		ExpiresAt:        timestamppb.New(card.ExpiresAt),
+		RepetitionNumber: int32(card.RepetitionNumber),
+		DeckId:           card.DeckID.String(),
+		Tags:             card.Tags,
+		IsPublic:         card.IsPublic,
+		Stability:        card.Stability,
+		Difficulty:       card.Difficulty,
+		Lapses:           int32(card.Lapses),
+		LastReviewedAt:   toProtoTimestamp(card.LastReviewedAt),
+		Algorithm:        card.Algorithm,
+		Phase:            card.Phase,
+		Step:             int32(card.Step),
+		DeletedAt:        toProtoDeletedAt(card.DeletedAt),
+		SourceCardId:     toProtoOptionalId(card.SourceCardId),
+		KeepLocal:        card.KeepLocal,
+		Template:         card.Template,
+		NoteId:           toProtoOptionalId(card.NoteId),
+		BuriedUntil:      toProtoTimestamp(card.BuriedUntil),
+		Ordinal:          int32(card.Ordinal),
+		Siblings:         siblings,
+		Front:            front,
+		Back:             back,
+
+		Rank:                 card.Rank,
+		WordHighlight:        card.WordHighlight,
+		TranslationHighlight: card.TranslationHighlight,
+	}
+}
+
+func FromProtoToUpdateSchemeCard(card *cardv1.UpdateCardRequest) *schemes.UpdateCardScheme {
+	return &schemes.UpdateCardScheme{
+		Word:             card.Word,
+		Translation:      card.Translation,
+		Easiness:         card.Easiness,
+		Interval:         int(card.Interval),
+		ExpiresAt:        card.ExpiresAt.AsTime(),
+		RepetitionNumber: int(card.RepetitionNumber),
+		Tags:             card.Tags,
+	}
+}
+
+func FromProtoToAnswerSchemeCard(answer *cardv1.Answer) (*schemes.AnswerScheme, error) {
+	cardId, err := uuid.Parse(answer.CardId)
+	if err != nil {
+		return nil, err
+	}
+	var answeredAt time.Time
+	if answer.AnsweredAt != nil {
+		answeredAt = answer.AnsweredAt.AsTime()
+	}
+	return &schemes.AnswerScheme{
+		CardId:     cardId,
+		Grade:      int(answer.Grade),
+		RequestId:  answer.RequestId,
+		AnsweredAt: answeredAt,
+	}, nil
+}
+
+func FromAnswerSchemeToProtoCard(answer *schemes.AnswerScheme) (*cardv1.Answer, error) {
+	cardId, err := uuid.Parse(answer.CardId.String())
+	if err != nil {
+		return nil, err
+	}
+	var answeredAt *timestamppb.Timestamp
+	if !answer.AnsweredAt.IsZero() {
+		answeredAt = timestamppb.New(answer.AnsweredAt)
+	}
+	return &cardv1.Answer{
+		CardId:     cardId.String(),
+		Grade:      int32(answer.Grade),
+		RequestId:  answer.RequestId,
+		AnsweredAt: answeredAt,
+	}, nil
+}
+
+func FromAnswerResultsToProto(results []schemes.AnswerResult) []*cardv1.AnswerResult {
+	var result []*cardv1.AnswerResult
+	for _, r := range results {
+		result = append(result, &cardv1.AnswerResult{
+			CardId:       r.CardId.String(),
+			RequestId:    r.RequestId,
+			ReviewId:     r.ReviewId,
+			NextReviewAt: timestamppb.New(r.NextReviewAt),
+			Duplicate:    r.Duplicate,
+		})
+	}
+	return result
+}
+
+func FromProtoToAnswerResults(results []*cardv1.AnswerResult) ([]schemes.AnswerResult, error) {
+	var result []schemes.AnswerResult
+	for _, r := range results {
+		cardId, err := uuid.Parse(r.CardId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, schemes.AnswerResult{
+			CardId:       cardId,
+			RequestId:    r.RequestId,
+			ReviewId:     r.ReviewId,
+			NextReviewAt: r.NextReviewAt.AsTime(),
+			Duplicate:    r.Duplicate,
+		})
+	}
+	return result, nil
+}
+
+func FromSyncConflictsToProto(conflicts []schemes.SyncConflict) []*cardv1.SyncConflict {
+	var result []*cardv1.SyncConflict
+	for _, c := range conflicts {
+		result = append(result, &cardv1.SyncConflict{
+			CardId:    c.CardId.String(),
+			RequestId: c.RequestId,
+			Reason:    c.Reason,
+		})
+	}
+	return result
+}
+
+func FromProtoToSyncConflicts(conflicts []*cardv1.SyncConflict) ([]schemes.SyncConflict, error) {
+	var result []schemes.SyncConflict
+	for _, c := range conflicts {
+		cardId, err := uuid.Parse(c.CardId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, schemes.SyncConflict{
+			CardId:    cardId,
+			RequestId: c.RequestId,
+			Reason:    c.Reason,
+		})
+	}
+	return result, nil
+}
+
+func FromAnswerSchemesToProtosCard(answers []*schemes.AnswerScheme) ([]*cardv1.Answer, error) {
+	var result []*cardv1.Answer
+	for _, answer := range answers {
+		converted, err := FromAnswerSchemeToProtoCard(answer)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+func FromProtoToModelDeck(deck *deckv1.Deck) (*modelDeck.Deck, error) {
+	deckId, err := uuid.Parse(deck.DeckId)
+	if err != nil {
+		return nil, err
+	}
+	createdBy, err := uuid.Parse(deck.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	sourceDeckId, err := fromProtoOptionalId(deck.SourceDeckId)
+	if err != nil {
+		return nil, err
+	}
+	parentDeckId, err := fromProtoOptionalId(deck.ParentDeckId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelDeck.Deck{
+		DeckId:        deckId,
+		CreatedBy:     createdBy,
+		CreatedAt:     deck.CreatedAt.AsTime(),
+		Name:          deck.Name,
+		CardsQuantity: uint(deck.CardsQuantity),
+		Description:   deck.Description,
+		IsPublic:      deck.IsPublic,
+		DeletedAt:     fromProtoDeletedAt(deck.DeletedAt),
+		SourceDeckId:  sourceDeckId,
+		Version:       int(deck.Version),
+		Subscribed:    deck.Subscribed,
+		SyncedVersion: int(deck.SyncedVersion),
+		ParentDeckId:  parentDeckId,
+
+		Rank:                 deck.Rank,
+		NameHighlight:        deck.NameHighlight,
+		DescriptionHighlight: deck.DescriptionHighlight,
+		Role:                 deck.Role,
+	}, nil
+}
+func FromModelToProtoDeck(deck *modelDeck.Deck) *deckv1.Deck {
+	return &deckv1.Deck{
+		DeckId:        deck.DeckId.String(),
+		CreatedBy:     deck.CreatedBy.String(),
+		CreatedAt:     timestamppb.New(deck.CreatedAt),
+		Name:          deck.Name,
+		Description:   deck.Description,
+		CardsQuantity: uint32(deck.CardsQuantity),
+		IsPublic:      deck.IsPublic,
+		DeletedAt:     toProtoDeletedAt(deck.DeletedAt),
+		SourceDeckId:  toProtoOptionalId(deck.SourceDeckId),
+		Version:       int32(deck.Version),
+		Subscribed:    deck.Subscribed,
+		SyncedVersion: int32(deck.SyncedVersion),
+		ParentDeckId:  toProtoOptionalId(deck.ParentDeckId),
+
+		Rank:                 deck.Rank,
+		NameHighlight:        deck.NameHighlight,
+		DescriptionHighlight: deck.DescriptionHighlight,
+		Role:                 deck.Role,
+	}
+}
+
+func FromModelToProtoDeckMember(member *modelDeck.Member) *deckv1.DeckMember {
+	return &deckv1.DeckMember{
+		DeckId:    member.DeckId.String(),
+		UserId:    member.UserId.String(),
+		Role:      member.Role,
+		InvitedBy: member.InvitedBy.String(),
+		CreatedAt: timestamppb.New(member.CreatedAt),
+	}
+}
+
+func FromProtoToModelDeckMember(member *deckv1.DeckMember) (*modelDeck.Member, error) {
+	deckId, err := uuid.Parse(member.DeckId)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := uuid.Parse(member.UserId)
+	if err != nil {
+		return nil, err
+	}
+	invitedBy, err := uuid.Parse(member.InvitedBy)
+	if err != nil {
+		return nil, err
+	}
+	return &modelDeck.Member{
+		DeckId:    deckId,
+		UserId:    userId,
+		Role:      member.Role,
+		InvitedBy: invitedBy,
+		CreatedAt: member.CreatedAt.AsTime(),
+	}, nil
+}
+
+func FromModelToProtoDueCount(count *modelDeck.DueCount) *deckv1.DeckDueCount {
+	return &deckv1.DeckDueCount{
+		DeckId:        count.DeckId.String(),
+		ParentDeckId:  toProtoOptionalId(count.ParentDeckId),
+		Name:          count.Name,
+		New:           int32(count.New),
+		Learning:      int32(count.Learning),
+		Review:        int32(count.Review),
+		TotalNew:      int32(count.TotalNew),
+		TotalLearning: int32(count.TotalLearning),
+		TotalReview:   int32(count.TotalReview),
+	}
+}
+
+func FromProtoToModelDueCount(count *deckv1.DeckDueCount) (*modelDeck.DueCount, error) {
+	deckId, err := uuid.Parse(count.DeckId)
+	if err != nil {
+		return nil, err
+	}
+	parentDeckId, err := fromProtoOptionalId(count.ParentDeckId)
+	if err != nil {
+		return nil, err
+	}
+	return &modelDeck.DueCount{
+		DeckId:        deckId,
+		ParentDeckId:  parentDeckId,
+		Name:          count.Name,
+		New:           int(count.New),
+		Learning:      int(count.Learning),
+		Review:        int(count.Review),
+		TotalNew:      int(count.TotalNew),
+		TotalLearning: int(count.TotalLearning),
+		TotalReview:   int(count.TotalReview),
+	}, nil
+}
+
+func FromModelToProtoTagCounts(tags []model.TagCount) []*cardv1.TagCount {
+	result := make([]*cardv1.TagCount, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, &cardv1.TagCount{Tag: tag.Tag, Count: tag.Count})
+	}
+	return result
+}
+
+func FromProtoToModelTagCounts(tags []*cardv1.TagCount) []model.TagCount {
+	result := make([]model.TagCount, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, model.TagCount{Tag: tag.Tag, Count: tag.Count})
+	}
+	return result
+}
+
+func FromImportRowsToProto(rows []schemes.ImportRow) []*cardv1.ImportRow {
+	result := make([]*cardv1.ImportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &cardv1.ImportRow{
+			Line:        int32(row.Line),
+			Word:        row.Word,
+			Translation: row.Translation,
+			Tags:        row.Tags,
+			Schedule:    fromImportScheduleToProto(row.Schedule),
+			Reviews:     FromReviewsToProto(row.Reviews),
+		})
+	}
+	return result
+}
+
+func fromImportScheduleToProto(schedule *schemes.ImportSchedule) *cardv1.ImportSchedule {
+	if schedule == nil {
+		return nil
+	}
+	return &cardv1.ImportSchedule{
+		Phase:            schedule.Phase,
+		Interval:         int32(schedule.Interval),
+		Easiness:         schedule.Easiness,
+		RepetitionNumber: int32(schedule.RepetitionNumber),
+		Lapses:           int32(schedule.Lapses),
+		ExpiresAt:        toProtoTimestamp(&schedule.ExpiresAt),
+		LastReviewedAt:   toProtoTimestamp(schedule.LastReviewedAt),
+		Stability:        schedule.Stability,
+		Difficulty:       schedule.Difficulty,
+	}
+}
+
+func fromProtoToImportSchedule(schedule *cardv1.ImportSchedule) *schemes.ImportSchedule {
+	if schedule == nil {
+		return nil
+	}
+	result := &schemes.ImportSchedule{
+		Phase:            schedule.Phase,
+		Interval:         int(schedule.Interval),
+		Easiness:         schedule.Easiness,
+		RepetitionNumber: int(schedule.RepetitionNumber),
+		Lapses:           int(schedule.Lapses),
+		LastReviewedAt:   fromProtoTimestamp(schedule.LastReviewedAt),
+		Stability:        schedule.Stability,
+		Difficulty:       schedule.Difficulty,
+	}
+	if expiresAt := fromProtoTimestamp(schedule.ExpiresAt); expiresAt != nil {
+		result.ExpiresAt = *expiresAt
+	}
+	return result
+}
+
+func FromProtoToImportRows(rows []*cardv1.ImportRow) ([]schemes.ImportRow, error) {
+	result := make([]schemes.ImportRow, 0, len(rows))
+	for _, row := range rows {
+		reviews, err := FromProtoToReviews(row.Reviews)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, schemes.ImportRow{
+			Line:        int(row.Line),
+			Word:        row.Word,
+			Translation: row.Translation,
+			Tags:        row.Tags,
+			Schedule:    fromProtoToImportSchedule(row.Schedule),
+			Reviews:     reviews,
+		})
+	}
+	return result, nil
+}
+
+func FromReviewsToProto(reviews []schemes.Review) []*cardv1.Review {
+	if reviews == nil {
+		return nil
+	}
+	result := make([]*cardv1.Review, 0, len(reviews))
+	for _, review := range reviews {
+		cardId := ""
+		if review.CardId != uuid.Nil {
+			cardId = review.CardId.String()
+		}
+		result = append(result, &cardv1.Review{
+			CardId:     cardId,
+			Grade:      int32(review.Grade),
+			ReviewedAt: timestamppb.New(review.ReviewedAt),
+			Phase:      review.Phase,
+			Interval:   int32(review.Interval),
+			Easiness:   review.Easiness,
+			Stability:  review.Stability,
+			Difficulty: review.Difficulty,
+		})
+	}
+	return result
+}
+
+func FromProtoToReviews(reviews []*cardv1.Review) ([]schemes.Review, error) {
+	if reviews == nil {
+		return nil, nil
+	}
+	result := make([]schemes.Review, 0, len(reviews))
+	for _, review := range reviews {
+		var cardId uuid.UUID
+		if review.CardId != "" {
+			var err error
+			if cardId, err = uuid.Parse(review.CardId); err != nil {
+				return nil, fmt.Errorf("cardId is invalid: %w", err)
+			}
+		}
+		result = append(result, schemes.Review{
+			CardId:     cardId,
+			Grade:      int(review.Grade),
+			ReviewedAt: review.ReviewedAt.AsTime(),
+			Phase:      review.Phase,
+			Interval:   int(review.Interval),
+			Easiness:   review.Easiness,
+			Stability:  review.Stability,
+			Difficulty: review.Difficulty,
+		})
+	}
+	return result, nil
+}
+
+func FromImportResultToProto(importResult *schemes.ImportResult) *cardv1.ImportCardsResponse {
+	errs := make([]*cardv1.ImportRowError, 0, len(importResult.Errors))
+	for _, rowError := range importResult.Errors {
+		errs = append(errs, &cardv1.ImportRowError{Line: int32(rowError.Line), Error: rowError.Error})
+	}
+	return &cardv1.ImportCardsResponse{
+		Imported:   int32(importResult.Imported),
+		Duplicates: int32(importResult.Duplicates),
+		Errors:     errs,
+	}
+}
+
+func FromProtoToImportResult(response *cardv1.ImportCardsResponse) *schemes.ImportResult {
+	errs := make([]schemes.ImportRowError, 0, len(response.Errors))
+	for _, rowError := range response.Errors {
+		errs = append(errs, schemes.ImportRowError{Line: int(rowError.Line), Error: rowError.Error})
+	}
+	return &schemes.ImportResult{
+		Imported:   int(response.Imported),
+		Duplicates: int(response.Duplicates),
+		Errors:     errs,
+	}
+}
+
+func FromModelToProtoPreferences(preference *model.Preference) *cardv1.Preferences {
+	return &cardv1.Preferences{
+		UserId:    preference.UserId.String(),
+		Algorithm: preference.Algorithm,
+		UpdatedAt: timestamppb.New(preference.UpdatedAt),
+	}
+}
+
+func FromProtoToModelPreferences(preferences *cardv1.Preferences) (*model.Preference, error) {
+	userId, err := uuid.Parse(preferences.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("userId is invalid: %w", err)
+	}
+	return &model.Preference{
+		UserId:    userId,
+		Algorithm: preferences.Algorithm,
+		UpdatedAt: preferences.UpdatedAt.AsTime(),
+	}, nil
+}
+
+func FromModelToProtoDeckOptions(options *modelDeck.Options) *deckv1.DeckOptions {
+	return &deckv1.DeckOptions{
+		DeckId:             options.DeckId.String(),
+		LearningSteps:      toInt32s(options.LearningSteps),
+		RelearningSteps:    toInt32s(options.RelearningSteps),
+		GraduatingInterval: int32(options.GraduatingInterval),
+		EasyInterval:       int32(options.EasyInterval),
+		MaximumInterval:    int32(options.MaximumInterval),
+		UpdatedAt:          timestamppb.New(options.UpdatedAt),
+		NewPerDay:          int32(options.NewPerDay),
+		MaxReviewsPerDay:   int32(options.MaxReviewsPerDay),
+		Algorithm:          options.Algorithm,
+		BurySiblings:       options.BurySiblings,
+		NewCardOrder:       options.NewCardOrder,
+	}
+}
+
+func FromProtoToModelDeckOptions(options *deckv1.DeckOptions) (*modelDeck.Options, error) {
+	deckId, err := uuid.Parse(options.DeckId)
+	if err != nil {
+		return nil, fmt.Errorf("deckId is invalid: %w", err)
+	}
+	return &modelDeck.Options{
+		DeckId:             deckId,
+		LearningSteps:      toInt64s(options.LearningSteps),
+		RelearningSteps:    toInt64s(options.RelearningSteps),
+		GraduatingInterval: int(options.GraduatingInterval),
+		EasyInterval:       int(options.EasyInterval),
+		MaximumInterval:    int(options.MaximumInterval),
+		UpdatedAt:          options.UpdatedAt.AsTime(),
+		NewPerDay:          int(options.NewPerDay),
+		MaxReviewsPerDay:   int(options.MaxReviewsPerDay),
+		Algorithm:          options.Algorithm,
+		BurySiblings:       options.BurySiblings,
+		NewCardOrder:       options.NewCardOrder,
+	}, nil
+}
+
+func toInt32s(values []int64) []int32 {
+	result := make([]int32, 0, len(values))
+	for _, v := range values {
+		result = append(result, int32(v))
+	}
+	return result
+}
+
+func toInt64s(values []int32) []int64 {
+	result := make([]int64, 0, len(values))
+	for _, v := range values {
+		result = append(result, int64(v))
+	}
+	return result
+}
+
+func FromModelToProtoCardRevision(revision *model.CardRevision) *cardv1.CardRevision {
+	var changes []*cardv1.FieldChange
+	for _, change := range revision.Changes {
+		changes = append(changes, &cardv1.FieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+
+	return &cardv1.CardRevision{
+		CardId:       revision.CardId.String(),
+		Revision:     int32(revision.Revision),
+		EditedBy:     revision.EditedBy.String(),
+		EditedAt:     timestamppb.New(revision.EditedAt),
+		Changes:      changes,
+		RevertedFrom: int32(revision.RevertedFrom),
+		Word:         revision.Word,
+		Translation:  revision.Translation,
+		Tags:         revision.Tags,
+		IsPublic:     revision.IsPublic,
+	}
+}
+
+func FromProtoToModelCardRevision(revision *cardv1.CardRevision) (*model.CardRevision, error) {
+	cardId, err := uuid.Parse(revision.CardId)
+	if err != nil {
+		return nil, err
+	}
+	editedBy, err := uuid.Parse(revision.EditedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []model.FieldChange
+	for _, change := range revision.Changes {
+		changes = append(changes, model.FieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+
+	return &model.CardRevision{
+		CardId:       cardId,
+		Revision:     int(revision.Revision),
+		EditedBy:     editedBy,
+		EditedAt:     revision.EditedAt.AsTime(),
+		Changes:      changes,
+		RevertedFrom: int(revision.RevertedFrom),
+		Word:         revision.Word,
+		Translation:  revision.Translation,
+		Tags:         revision.Tags,
+		IsPublic:     revision.IsPublic,
+	}, nil
+}
+
+func FromDeckChangesToProto(changes []model.DeckChange) []*cardv1.DeckChange {
+	result := make([]*cardv1.DeckChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, &cardv1.DeckChange{
+			Version:     int32(change.Version),
+			CardId:      change.CardId.String(),
+			Action:      change.Action,
+			Word:        change.Word,
+			Translation: change.Translation,
+			Tags:        change.Tags,
+			PublishedAt: timestamppb.New(change.PublishedAt),
+			Template:    change.Template,
+			NoteId:      toProtoOptionalId(change.NoteId),
+			Ordinal:     int32(change.Ordinal),
+		})
+	}
+	return result
+}
+
+func FromProtoToDeckChanges(deckId uuid.UUID, changes []*cardv1.DeckChange) ([]model.DeckChange, error) {
+	result := make([]model.DeckChange, 0, len(changes))
+	for _, change := range changes {
+		cardId, err := uuid.Parse(change.CardId)
+		if err != nil {
+			return nil, fmt.Errorf("cardId is invalid: %w", err)
+		}
+		noteId, err := fromProtoOptionalId(change.NoteId)
+		if err != nil {
+			return nil, fmt.Errorf("noteId is invalid: %w", err)
+		}
+		result = append(result, model.DeckChange{
+			DeckId:      deckId,
+			Version:     int(change.Version),
+			CardId:      cardId,
+			Action:      change.Action,
+			Word:        change.Word,
+			Translation: change.Translation,
+			Tags:        change.Tags,
+			PublishedAt: change.PublishedAt.AsTime(),
+			Template:    change.Template,
+			NoteId:      noteId,
+			Ordinal:     int(change.Ordinal),
+		})
+	}
+	return result, nil
+}
+
+func FromPublishResultToProto(result *schemes.PublishResult) *cardv1.PublishDeckResponse {
+	return &cardv1.PublishDeckResponse{
+		Version: int32(result.Version),
+		Added:   int32(result.Added),
+		Edited:  int32(result.Edited),
+		Deleted: int32(result.Deleted),
+	}
+}
+
+func FromProtoToPublishResult(resp *cardv1.PublishDeckResponse) *schemes.PublishResult {
+	return &schemes.PublishResult{
+		Version: int(resp.Version),
+		Added:   int(resp.Added),
+		Edited:  int(resp.Edited),
+		Deleted: int(resp.Deleted),
+	}
+}
+
+func FromDeckSyncResultToProto(result *schemes.DeckSyncResult) *cardv1.SyncDeckResponse {
+	return &cardv1.SyncDeckResponse{
+		Version: int32(result.Version),
+		Added:   int32(result.Added),
+		Updated: int32(result.Updated),
+		Deleted: int32(result.Deleted),
+		Kept:    int32(result.Kept),
+	}
+}
+
+func FromProtoToDeckSyncResult(resp *cardv1.SyncDeckResponse) *schemes.DeckSyncResult {
+	return &schemes.DeckSyncResult{
+		Version: int(resp.Version),
+		Added:   int(resp.Added),
+		Updated: int(resp.Updated),
+		Deleted: int(resp.Deleted),
+		Kept:    int(resp.Kept),
+	}
+}
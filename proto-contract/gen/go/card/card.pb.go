@@ -0,0 +1,4459 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: card/card.proto
+
+package cardv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Message for a Card
+type Card struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CardId           string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Word             string                 `protobuf:"bytes,4,opt,name=word,proto3" json:"word,omitempty"`
+	Translation      string                 `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"`
+	Easiness         float64                `protobuf:"fixed64,6,opt,name=easiness,proto3" json:"easiness,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Interval         int32                  `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"` // in days
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RepetitionNumber int32                  `protobuf:"varint,10,opt,name=repetition_number,json=repetitionNumber,proto3" json:"repetition_number,omitempty"`
+	DeckId           string                 `protobuf:"bytes,11,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	Tags             []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsPublic         bool                   `protobuf:"varint,13,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// FSRS memory state, zero until the card is reviewed with FSRS
+	Stability      float64                `protobuf:"fixed64,14,opt,name=stability,proto3" json:"stability,omitempty"`
+	Difficulty     float64                `protobuf:"fixed64,15,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Lapses         int32                  `protobuf:"varint,16,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	LastReviewedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`
+	// Algorithm that produced the current schedule ("sm2" or "fsrs")
+	Algorithm string `protobuf:"bytes,18,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Learning phase: "new", "learning", "review" or "relearning"
+	Phase string `protobuf:"bytes,19,opt,name=phase,proto3" json:"phase,omitempty"`
+	Step  int32  `protobuf:"varint,20,opt,name=step,proto3" json:"step,omitempty"`
+	// Set while the card is in trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Full-text search results only: relevance and matches wrapped in <b></b>
+	Rank                 float32 `protobuf:"fixed32,22,opt,name=rank,proto3" json:"rank,omitempty"`
+	WordHighlight        string  `protobuf:"bytes,23,opt,name=word_highlight,json=wordHighlight,proto3" json:"word_highlight,omitempty"`
+	TranslationHighlight string  `protobuf:"bytes,24,opt,name=translation_highlight,json=translationHighlight,proto3" json:"translation_highlight,omitempty"`
+	// Card of another deck this one was cloned from, empty for cards made by the user
+	SourceCardId string `protobuf:"bytes,25,opt,name=source_card_id,json=sourceCardId,proto3" json:"source_card_id,omitempty"`
+	// Set when the user edited a cloned card, changes of the source card are not merged then
+	KeepLocal bool `protobuf:"varint,26,opt,name=keep_local,json=keepLocal,proto3" json:"keep_local,omitempty"`
+	// Side asked: "forward" (word first), "reverse" (translation first) or "cloze"
+	// (word with cloze deletions). Adding a card with "both" makes a forward card
+	// with a reverse sibling, adding a cloze card makes one card per cloze index
+	Template string `protobuf:"bytes,27,opt,name=template,proto3" json:"template,omitempty"`
+	// Shared by sibling cards added together, empty for cards without siblings
+	NoteId string `protobuf:"bytes,28,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Set when a sibling was reviewed and the deck buries siblings, the card is not studied until then
+	BuriedUntil *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=buried_until,json=buriedUntil,proto3" json:"buried_until,omitempty"`
+	// AddCard only: other cards added together with this one
+	Siblings []*Card `protobuf:"bytes,30,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// Cloze index asked by a cloze card
+	Ordinal int32 `protobuf:"varint,31,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	// Rendered text asked on the front and shown on the back, ignored on input
+	Front         string `protobuf:"bytes,32,opt,name=front,proto3" json:"front,omitempty"`
+	Back          string `protobuf:"bytes,33,opt,name=back,proto3" json:"back,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_card_card_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Card) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *Card) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Card) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Card) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Card) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *Card) GetEasiness() float64 {
+	if x != nil {
+		return x.Easiness
+	}
+	return 0
+}
+
+func (x *Card) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Card) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Card) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Card) GetRepetitionNumber() int32 {
+	if x != nil {
+		return x.RepetitionNumber
+	}
+	return 0
+}
+
+func (x *Card) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *Card) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Card) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Card) GetStability() float64 {
+	if x != nil {
+		return x.Stability
+	}
+	return 0
+}
+
+func (x *Card) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *Card) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *Card) GetLastReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return nil
+}
+
+func (x *Card) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Card) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Card) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *Card) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Card) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Card) GetWordHighlight() string {
+	if x != nil {
+		return x.WordHighlight
+	}
+	return ""
+}
+
+func (x *Card) GetTranslationHighlight() string {
+	if x != nil {
+		return x.TranslationHighlight
+	}
+	return ""
+}
+
+func (x *Card) GetSourceCardId() string {
+	if x != nil {
+		return x.SourceCardId
+	}
+	return ""
+}
+
+func (x *Card) GetKeepLocal() bool {
+	if x != nil {
+		return x.KeepLocal
+	}
+	return false
+}
+
+func (x *Card) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Card) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *Card) GetBuriedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BuriedUntil
+	}
+	return nil
+}
+
+func (x *Card) GetSiblings() []*Card {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+func (x *Card) GetOrdinal() int32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+func (x *Card) GetFront() string {
+	if x != nil {
+		return x.Front
+	}
+	return ""
+}
+
+func (x *Card) GetBack() string {
+	if x != nil {
+		return x.Back
+	}
+	return ""
+}
+
+// Request and response for AddCard
+type AddCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCardRequest) Reset() {
+	*x = AddCardRequest{}
+	mi := &file_card_card_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardRequest) ProtoMessage() {}
+
+func (x *AddCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardRequest.ProtoReflect.Descriptor instead.
+func (*AddCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddCardRequest) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type AddCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCardResponse) Reset() {
+	*x = AddCardResponse{}
+	mi := &file_card_card_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardResponse) ProtoMessage() {}
+
+func (x *AddCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardResponse.ProtoReflect.Descriptor instead.
+func (*AddCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type ReadStudyQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // empty for all decks, otherwise the deck with all of its subdecks
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`                     // empty for cards with any tags
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadStudyQueueRequest) Reset() {
+	*x = ReadStudyQueueRequest{}
+	mi := &file_card_card_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadStudyQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStudyQueueRequest) ProtoMessage() {}
+
+func (x *ReadStudyQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStudyQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReadStudyQueueRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadStudyQueueRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *ReadStudyQueueRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ReadAllCardsToLearnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllCardsToLearnResponse) Reset() {
+	*x = ReadAllCardsToLearnResponse{}
+	mi := &file_card_card_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllCardsToLearnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllCardsToLearnResponse) ProtoMessage() {}
+
+func (x *ReadAllCardsToLearnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllCardsToLearnResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllCardsToLearnResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReadAllCardsToLearnResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// Keyset pagination of list RPCs. Cursor is empty for the first page and taken
+// from next_cursor of the previous response afterwards
+type PageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 50 by default, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_card_card_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PageRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *PageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReadAllOwnCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"` // only cards with the tag if set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllOwnCardsRequest) Reset() {
+	*x = ReadAllOwnCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllOwnCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllOwnCardsRequest) ProtoMessage() {}
+
+func (x *ReadAllOwnCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllOwnCardsRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllOwnCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadAllOwnCardsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReadAllOwnCardsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadAllOwnCardsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ReadAllOwnCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllOwnCardsResponse) Reset() {
+	*x = ReadAllOwnCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllOwnCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllOwnCardsResponse) ProtoMessage() {}
+
+func (x *ReadAllOwnCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllOwnCardsResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllOwnCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadAllOwnCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *ReadAllOwnCardsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Query is matched against word, translation and tags, empty query lists all
+type SearchPublicCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPublicCardsRequest) Reset() {
+	*x = SearchPublicCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPublicCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPublicCardsRequest) ProtoMessage() {}
+
+func (x *SearchPublicCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPublicCardsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPublicCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchPublicCardsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPublicCardsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchPublicCardsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchAllPublicCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAllPublicCardsResponse) Reset() {
+	*x = SearchAllPublicCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAllPublicCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllPublicCardsResponse) ProtoMessage() {}
+
+func (x *SearchAllPublicCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllPublicCardsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAllPublicCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchAllPublicCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *SearchAllPublicCardsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SearchUserPublicCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUserPublicCardsRequest) Reset() {
+	*x = SearchUserPublicCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUserPublicCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserPublicCardsRequest) ProtoMessage() {}
+
+func (x *SearchUserPublicCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserPublicCardsRequest.ProtoReflect.Descriptor instead.
+func (*SearchUserPublicCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchUserPublicCardsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchUserPublicCardsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchUserPublicCardsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUserPublicCardsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchUserPublicCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUserPublicCardsResponse) Reset() {
+	*x = SearchUserPublicCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUserPublicCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserPublicCardsResponse) ProtoMessage() {}
+
+func (x *SearchUserPublicCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserPublicCardsResponse.ProtoReflect.Descriptor instead.
+func (*SearchUserPublicCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchUserPublicCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *SearchUserPublicCardsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Request and response for UpdateCard
+type UpdateCardRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CardId           string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Word             string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Translation      string                 `protobuf:"bytes,3,opt,name=translation,proto3" json:"translation,omitempty"`
+	Easiness         float64                `protobuf:"fixed64,4,opt,name=easiness,proto3" json:"easiness,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Interval         int32                  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RepetitionNumber int32                  `protobuf:"varint,8,opt,name=repetition_number,json=repetitionNumber,proto3" json:"repetition_number,omitempty"`
+	Tags             []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	UserId           string                 `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsPublic         bool                   `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateCardRequest) Reset() {
+	*x = UpdateCardRequest{}
+	mi := &file_card_card_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardRequest) ProtoMessage() {}
+
+func (x *UpdateCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetEasiness() float64 {
+	if x != nil {
+		return x.Easiness
+	}
+	return 0
+}
+
+func (x *UpdateCardRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UpdateCardRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *UpdateCardRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UpdateCardRequest) GetRepetitionNumber() int32 {
+	if x != nil {
+		return x.RepetitionNumber
+	}
+	return 0
+}
+
+func (x *UpdateCardRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateCardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCardRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type UpdateCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCardResponse) Reset() {
+	*x = UpdateCardResponse{}
+	mi := &file_card_card_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardResponse) ProtoMessage() {}
+
+func (x *UpdateCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// Request and response for DeleteCard
+type DeleteCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardRequest) Reset() {
+	*x = DeleteCardRequest{}
+	mi := &file_card_card_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardRequest) ProtoMessage() {}
+
+func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+type DeleteCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardResponse) Reset() {
+	*x = DeleteCardResponse{}
+	mi := &file_card_card_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardResponse) ProtoMessage() {}
+
+func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TrashedCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedCardsResponse) Reset() {
+	*x = TrashedCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedCardsResponse) ProtoMessage() {}
+
+func (x *TrashedCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedCardsResponse.ProtoReflect.Descriptor instead.
+func (*TrashedCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TrashedCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// Request and response for RestoreCard
+type RestoreCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCardRequest) Reset() {
+	*x = RestoreCardRequest{}
+	mi := &file_card_card_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardRequest) ProtoMessage() {}
+
+func (x *RestoreCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+type RestoreCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCardResponse) Reset() {
+	*x = RestoreCardResponse{}
+	mi := &file_card_card_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardResponse) ProtoMessage() {}
+
+func (x *RestoreCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// Request and response for ReleaseDeckCards
+type ReleaseDeckCardsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DeckId       string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	Mode         string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                                       // "delete", "move" or "detach"
+	TargetDeckId string                 `protobuf:"bytes,3,opt,name=target_deck_id,json=targetDeckId,proto3" json:"target_deck_id,omitempty"` // required for "move"
+	// Deletion time of the deck, trashed cards get the same one to be restored with the deck
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDeckCardsRequest) Reset() {
+	*x = ReleaseDeckCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDeckCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDeckCardsRequest) ProtoMessage() {}
+
+func (x *ReleaseDeckCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDeckCardsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseDeckCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseDeckCardsRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *ReleaseDeckCardsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ReleaseDeckCardsRequest) GetTargetDeckId() string {
+	if x != nil {
+		return x.TargetDeckId
+	}
+	return ""
+}
+
+func (x *ReleaseDeckCardsRequest) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ReleaseDeckCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardsCount    int32                  `protobuf:"varint,1,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDeckCardsResponse) Reset() {
+	*x = ReleaseDeckCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDeckCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDeckCardsResponse) ProtoMessage() {}
+
+func (x *ReleaseDeckCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDeckCardsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseDeckCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseDeckCardsResponse) GetCardsCount() int32 {
+	if x != nil {
+		return x.CardsCount
+	}
+	return 0
+}
+
+// Request and response for CloneDeckCards
+type CloneDeckCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceDeckId  string                 `protobuf:"bytes,1,opt,name=source_deck_id,json=sourceDeckId,proto3" json:"source_deck_id,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneDeckCardsRequest) Reset() {
+	*x = CloneDeckCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneDeckCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneDeckCardsRequest) ProtoMessage() {}
+
+func (x *CloneDeckCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneDeckCardsRequest.ProtoReflect.Descriptor instead.
+func (*CloneDeckCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CloneDeckCardsRequest) GetSourceDeckId() string {
+	if x != nil {
+		return x.SourceDeckId
+	}
+	return ""
+}
+
+func (x *CloneDeckCardsRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type CloneDeckCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardsCount    int32                  `protobuf:"varint,1,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneDeckCardsResponse) Reset() {
+	*x = CloneDeckCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneDeckCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneDeckCardsResponse) ProtoMessage() {}
+
+func (x *CloneDeckCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneDeckCardsResponse.ProtoReflect.Descriptor instead.
+func (*CloneDeckCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CloneDeckCardsResponse) GetCardsCount() int32 {
+	if x != nil {
+		return x.CardsCount
+	}
+	return 0
+}
+
+// Message for a change of one card field
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_card_card_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// Message for a revision of card content
+type CardRevision struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CardId       string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Revision     int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	EditedBy     string                 `protobuf:"bytes,3,opt,name=edited_by,json=editedBy,proto3" json:"edited_by,omitempty"`
+	EditedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Changes      []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	RevertedFrom int32                  `protobuf:"varint,6,opt,name=reverted_from,json=revertedFrom,proto3" json:"reverted_from,omitempty"` // revision restored by this one, 0 for edits
+	// Card content after the change
+	Word          string   `protobuf:"bytes,7,opt,name=word,proto3" json:"word,omitempty"`
+	Translation   string   `protobuf:"bytes,8,opt,name=translation,proto3" json:"translation,omitempty"`
+	Tags          []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsPublic      bool     `protobuf:"varint,10,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardRevision) Reset() {
+	*x = CardRevision{}
+	mi := &file_card_card_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardRevision) ProtoMessage() {}
+
+func (x *CardRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardRevision.ProtoReflect.Descriptor instead.
+func (*CardRevision) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CardRevision) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *CardRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CardRevision) GetEditedBy() string {
+	if x != nil {
+		return x.EditedBy
+	}
+	return ""
+}
+
+func (x *CardRevision) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *CardRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *CardRevision) GetRevertedFrom() int32 {
+	if x != nil {
+		return x.RevertedFrom
+	}
+	return 0
+}
+
+func (x *CardRevision) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *CardRevision) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *CardRevision) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CardRevision) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+// Request and response for ReadCardHistory
+type ReadCardHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadCardHistoryRequest) Reset() {
+	*x = ReadCardHistoryRequest{}
+	mi := &file_card_card_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadCardHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadCardHistoryRequest) ProtoMessage() {}
+
+func (x *ReadCardHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadCardHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadCardHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReadCardHistoryRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+type ReadCardHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*CardRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadCardHistoryResponse) Reset() {
+	*x = ReadCardHistoryResponse{}
+	mi := &file_card_card_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadCardHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadCardHistoryResponse) ProtoMessage() {}
+
+func (x *ReadCardHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadCardHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadCardHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReadCardHistoryResponse) GetRevisions() []*CardRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Request and response for RevertCard
+type RevertCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertCardRequest) Reset() {
+	*x = RevertCardRequest{}
+	mi := &file_card_card_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertCardRequest) ProtoMessage() {}
+
+func (x *RevertCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertCardRequest.ProtoReflect.Descriptor instead.
+func (*RevertCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevertCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *RevertCardRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RevertCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertCardResponse) Reset() {
+	*x = RevertCardResponse{}
+	mi := &file_card_card_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertCardResponse) ProtoMessage() {}
+
+func (x *RevertCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertCardResponse.ProtoReflect.Descriptor instead.
+func (*RevertCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevertCardResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// Message for Answer
+type Answer struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CardId string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // UUID as string
+	Grade  int32                  `protobuf:"varint,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	// Client-supplied idempotency key, a retried answer with the same key is not applied twice
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// When the answer was actually given, required by SyncAnswers
+	AnsweredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=answered_at,json=answeredAt,proto3" json:"answered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	mi := &file_card_card_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Answer) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *Answer) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Answer) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Answer) GetAnsweredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnsweredAt
+	}
+	return nil
+}
+
+// Outcome of one answer. Duplicates return the outcome of the first submission
+type AnswerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ReviewId      string                 `protobuf:"bytes,3,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // empty if the card was not due and the answer was skipped
+	NextReviewAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_review_at,json=nextReviewAt,proto3" json:"next_review_at,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,5,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerResult) Reset() {
+	*x = AnswerResult{}
+	mi := &file_card_card_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerResult) ProtoMessage() {}
+
+func (x *AnswerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerResult.ProtoReflect.Descriptor instead.
+func (*AnswerResult) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AnswerResult) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *AnswerResult) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AnswerResult) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *AnswerResult) GetNextReviewAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextReviewAt
+	}
+	return nil
+}
+
+func (x *AnswerResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// Request and response for AddAnswers
+type AddAnswersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answers       []*Answer              `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAnswersRequest) Reset() {
+	*x = AddAnswersRequest{}
+	mi := &file_card_card_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAnswersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnswersRequest) ProtoMessage() {}
+
+func (x *AddAnswersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnswersRequest.ProtoReflect.Descriptor instead.
+func (*AddAnswersRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddAnswersRequest) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type SyncAnswersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answers       []*Answer              `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAnswersRequest) Reset() {
+	*x = SyncAnswersRequest{}
+	mi := &file_card_card_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAnswersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAnswersRequest) ProtoMessage() {}
+
+func (x *SyncAnswersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAnswersRequest.ProtoReflect.Descriptor instead.
+func (*SyncAnswersRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SyncAnswersRequest) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+// Answer that could not be replayed as given.
+// Reasons: "deleted" - card no longer exists, the answer is dropped;
+// "superseded" - card was reviewed later on the server, the answer is dropped;
+// "edited" - card was edited on the server after the answer, the answer is applied on top of the edit
+type SyncConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncConflict) Reset() {
+	*x = SyncConflict{}
+	mi := &file_card_card_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncConflict) ProtoMessage() {}
+
+func (x *SyncConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncConflict.ProtoReflect.Descriptor instead.
+func (*SyncConflict) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SyncConflict) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *SyncConflict) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SyncConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SyncAnswersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Results       []*AnswerResult        `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Conflicts     []*SyncConflict        `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncAnswersResponse) Reset() {
+	*x = SyncAnswersResponse{}
+	mi := &file_card_card_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncAnswersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncAnswersResponse) ProtoMessage() {}
+
+func (x *SyncAnswersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncAnswersResponse.ProtoReflect.Descriptor instead.
+func (*SyncAnswersResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SyncAnswersResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *SyncAnswersResponse) GetResults() []*AnswerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SyncAnswersResponse) GetConflicts() []*SyncConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type UndoLastAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastAnswerResponse) Reset() {
+	*x = UndoLastAnswerResponse{}
+	mi := &file_card_card_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastAnswerResponse) ProtoMessage() {}
+
+func (x *UndoLastAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastAnswerResponse.ProtoReflect.Descriptor instead.
+func (*UndoLastAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UndoLastAnswerResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type AddAnswersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Results       []*AnswerResult        `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAnswersResponse) Reset() {
+	*x = AddAnswersResponse{}
+	mi := &file_card_card_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAnswersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnswersResponse) ProtoMessage() {}
+
+func (x *AddAnswersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnswersResponse.ProtoReflect.Descriptor instead.
+func (*AddAnswersResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddAnswersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddAnswersResponse) GetResults() []*AnswerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Message for user's scheduling preferences
+type Preferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // "sm2" or "fsrs"
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_card_card_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Preferences) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Preferences) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Preferences) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request and response for ReadPreferences and UpdatePreferences
+type UpdatePreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_card_card_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdatePreferencesRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type PreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *Preferences           `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferencesResponse) Reset() {
+	*x = PreferencesResponse{}
+	mi := &file_card_card_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesResponse) ProtoMessage() {}
+
+func (x *PreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesResponse.ProtoReflect.Descriptor instead.
+func (*PreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PreferencesResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Request and response for RetagCards. A tag both added and removed is removed
+type RetagCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardIds       []string               `protobuf:"bytes,1,rep,name=card_ids,json=cardIds,proto3" json:"card_ids,omitempty"`
+	Add           []string               `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetagCardsRequest) Reset() {
+	*x = RetagCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetagCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetagCardsRequest) ProtoMessage() {}
+
+func (x *RetagCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetagCardsRequest.ProtoReflect.Descriptor instead.
+func (*RetagCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RetagCardsRequest) GetCardIds() []string {
+	if x != nil {
+		return x.CardIds
+	}
+	return nil
+}
+
+func (x *RetagCardsRequest) GetAdd() []string {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *RetagCardsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type RetagCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetagCardsResponse) Reset() {
+	*x = RetagCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetagCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetagCardsResponse) ProtoMessage() {}
+
+func (x *RetagCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetagCardsResponse.ProtoReflect.Descriptor instead.
+func (*RetagCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RetagCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_card_card_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReadTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadTagsResponse) Reset() {
+	*x = ReadTagsResponse{}
+	mi := &file_card_card_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTagsResponse) ProtoMessage() {}
+
+func (x *ReadTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTagsResponse.ProtoReflect.Descriptor instead.
+func (*ReadTagsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReadTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_card_card_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RenameTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardsCount    int64                  `protobuf:"varint,1,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"` // cards the tag was renamed on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_card_card_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RenameTagResponse) GetCardsCount() int64 {
+	if x != nil {
+		return x.CardsCount
+	}
+	return 0
+}
+
+// Row of an imported file, line is its number in the file for error reports
+type ImportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Translation   string                 `protobuf:"bytes,3,opt,name=translation,proto3" json:"translation,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Schedule      *ImportSchedule        `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"` // absent for new cards
+	Reviews       []*Review              `protobuf:"bytes,6,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	mi := &file_card_card_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRow) ProtoMessage() {}
+
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRow.ProtoReflect.Descriptor instead.
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRow) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *ImportRow) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *ImportRow) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportRow) GetSchedule() *ImportSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ImportRow) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+// Scheduling state of an imported card
+type ImportSchedule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Phase            string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`        // learning, review or relearning
+	Interval         int32                  `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"` // in days
+	Easiness         float64                `protobuf:"fixed64,3,opt,name=easiness,proto3" json:"easiness,omitempty"`
+	RepetitionNumber int32                  `protobuf:"varint,4,opt,name=repetition_number,json=repetitionNumber,proto3" json:"repetition_number,omitempty"`
+	Lapses           int32                  `protobuf:"varint,5,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastReviewedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`
+	Stability        float64                `protobuf:"fixed64,8,opt,name=stability,proto3" json:"stability,omitempty"`
+	Difficulty       float64                `protobuf:"fixed64,9,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportSchedule) Reset() {
+	*x = ImportSchedule{}
+	mi := &file_card_card_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSchedule) ProtoMessage() {}
+
+func (x *ImportSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSchedule.ProtoReflect.Descriptor instead.
+func (*ImportSchedule) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ImportSchedule) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ImportSchedule) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *ImportSchedule) GetEasiness() float64 {
+	if x != nil {
+		return x.Easiness
+	}
+	return 0
+}
+
+func (x *ImportSchedule) GetRepetitionNumber() int32 {
+	if x != nil {
+		return x.RepetitionNumber
+	}
+	return 0
+}
+
+func (x *ImportSchedule) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *ImportSchedule) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ImportSchedule) GetLastReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return nil
+}
+
+func (x *ImportSchedule) GetStability() float64 {
+	if x != nil {
+		return x.Stability
+	}
+	return 0
+}
+
+func (x *ImportSchedule) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+type ImportCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // read from the first message only
+	Rows          []*ImportRow           `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCardsRequest) Reset() {
+	*x = ImportCardsRequest{}
+	mi := &file_card_card_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCardsRequest) ProtoMessage() {}
+
+func (x *ImportCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCardsRequest.ProtoReflect.Descriptor instead.
+func (*ImportCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportCardsRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *ImportCardsRequest) GetRows() []*ImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_card_card_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // rows skipped as already present in the deck or the file
+	Errors        []*ImportRowError      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCardsResponse) Reset() {
+	*x = ImportCardsResponse{}
+	mi := &file_card_card_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCardsResponse) ProtoMessage() {}
+
+func (x *ImportCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCardsResponse.ProtoReflect.Descriptor instead.
+func (*ImportCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportCardsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportCardsResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportCardsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Answer given to a card with the state of the card before it
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // empty in imported rows
+	Grade         int32                  `protobuf:"varint,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Interval      int32                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Easiness      float64                `protobuf:"fixed64,6,opt,name=easiness,proto3" json:"easiness,omitempty"`
+	Stability     float64                `protobuf:"fixed64,7,opt,name=stability,proto3" json:"stability,omitempty"`
+	Difficulty    float64                `protobuf:"fixed64,8,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_card_card_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Review) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *Review) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Review) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *Review) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Review) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Review) GetEasiness() float64 {
+	if x != nil {
+		return x.Easiness
+	}
+	return 0
+}
+
+func (x *Review) GetStability() float64 {
+	if x != nil {
+		return x.Stability
+	}
+	return 0
+}
+
+func (x *Review) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+type ReadDeckReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDeckReviewsRequest) Reset() {
+	*x = ReadDeckReviewsRequest{}
+	mi := &file_card_card_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDeckReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeckReviewsRequest) ProtoMessage() {}
+
+func (x *ReadDeckReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeckReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ReadDeckReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReadDeckReviewsRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type ReadDeckReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDeckReviewsResponse) Reset() {
+	*x = ReadDeckReviewsResponse{}
+	mi := &file_card_card_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDeckReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeckReviewsResponse) ProtoMessage() {}
+
+func (x *ReadDeckReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeckReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ReadDeckReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReadDeckReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+// Request and response for PublishDeck
+type PublishDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishDeckRequest) Reset() {
+	*x = PublishDeckRequest{}
+	mi := &file_card_card_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDeckRequest) ProtoMessage() {}
+
+func (x *PublishDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDeckRequest.ProtoReflect.Descriptor instead.
+func (*PublishDeckRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PublishDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type PublishDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // unchanged when there was nothing to publish
+	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Edited        int32                  `protobuf:"varint,3,opt,name=edited,proto3" json:"edited,omitempty"`
+	Deleted       int32                  `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishDeckResponse) Reset() {
+	*x = PublishDeckResponse{}
+	mi := &file_card_card_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDeckResponse) ProtoMessage() {}
+
+func (x *PublishDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDeckResponse.ProtoReflect.Descriptor instead.
+func (*PublishDeckResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PublishDeckResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PublishDeckResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *PublishDeckResponse) GetEdited() int32 {
+	if x != nil {
+		return x.Edited
+	}
+	return 0
+}
+
+func (x *PublishDeckResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+// Message for one change of a card in a shared deck
+type DeckChange struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Version     int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CardId      string                 `protobuf:"bytes,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // card of the shared deck
+	Action      string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`               // "add", "edit" or "delete"
+	Word        string                 `protobuf:"bytes,4,opt,name=word,proto3" json:"word,omitempty"`                   // content after the change, empty for deletions
+	Translation string                 `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Template, note and cloze index of the card in the shared deck
+	Template      string `protobuf:"bytes,8,opt,name=template,proto3" json:"template,omitempty"`
+	NoteId        string `protobuf:"bytes,9,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Ordinal       int32  `protobuf:"varint,10,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckChange) Reset() {
+	*x = DeckChange{}
+	mi := &file_card_card_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckChange) ProtoMessage() {}
+
+func (x *DeckChange) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckChange.ProtoReflect.Descriptor instead.
+func (*DeckChange) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeckChange) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DeckChange) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *DeckChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DeckChange) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *DeckChange) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *DeckChange) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DeckChange) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *DeckChange) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *DeckChange) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *DeckChange) GetOrdinal() int32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+// Request and response for ReadDeckChanges
+type ReadDeckChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	SinceVersion  int32                  `protobuf:"varint,2,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDeckChangesRequest) Reset() {
+	*x = ReadDeckChangesRequest{}
+	mi := &file_card_card_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDeckChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeckChangesRequest) ProtoMessage() {}
+
+func (x *ReadDeckChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeckChangesRequest.ProtoReflect.Descriptor instead.
+func (*ReadDeckChangesRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReadDeckChangesRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *ReadDeckChangesRequest) GetSinceVersion() int32 {
+	if x != nil {
+		return x.SinceVersion
+	}
+	return 0
+}
+
+type ReadDeckChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // last published version of the deck
+	Changes       []*DeckChange          `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDeckChangesResponse) Reset() {
+	*x = ReadDeckChangesResponse{}
+	mi := &file_card_card_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDeckChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeckChangesResponse) ProtoMessage() {}
+
+func (x *ReadDeckChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeckChangesResponse.ProtoReflect.Descriptor instead.
+func (*ReadDeckChangesResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReadDeckChangesResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReadDeckChangesResponse) GetChanges() []*DeckChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Request and response for SyncDeck
+type SyncDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // the subscribed copy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncDeckRequest) Reset() {
+	*x = SyncDeckRequest{}
+	mi := &file_card_card_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncDeckRequest) ProtoMessage() {}
+
+func (x *SyncDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncDeckRequest.ProtoReflect.Descriptor instead.
+func (*SyncDeckRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SyncDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type SyncDeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // version of the source deck the copy is at now
+	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Deleted       int32                  `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Kept          int32                  `protobuf:"varint,5,opt,name=kept,proto3" json:"kept,omitempty"` // changes skipped for cards edited or deleted by the subscriber
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncDeckResponse) Reset() {
+	*x = SyncDeckResponse{}
+	mi := &file_card_card_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncDeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncDeckResponse) ProtoMessage() {}
+
+func (x *SyncDeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncDeckResponse.ProtoReflect.Descriptor instead.
+func (*SyncDeckResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SyncDeckResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SyncDeckResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *SyncDeckResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *SyncDeckResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *SyncDeckResponse) GetKept() int32 {
+	if x != nil {
+		return x.Kept
+	}
+	return 0
+}
+
+// Request and response for SetKeepLocal
+type SetKeepLocalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	KeepLocal     bool                   `protobuf:"varint,2,opt,name=keep_local,json=keepLocal,proto3" json:"keep_local,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKeepLocalRequest) Reset() {
+	*x = SetKeepLocalRequest{}
+	mi := &file_card_card_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKeepLocalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKeepLocalRequest) ProtoMessage() {}
+
+func (x *SetKeepLocalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKeepLocalRequest.ProtoReflect.Descriptor instead.
+func (*SetKeepLocalRequest) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetKeepLocalRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *SetKeepLocalRequest) GetKeepLocal() bool {
+	if x != nil {
+		return x.KeepLocal
+	}
+	return false
+}
+
+type SetKeepLocalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKeepLocalResponse) Reset() {
+	*x = SetKeepLocalResponse{}
+	mi := &file_card_card_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKeepLocalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKeepLocalResponse) ProtoMessage() {}
+
+func (x *SetKeepLocalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_card_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKeepLocalResponse.ProtoReflect.Descriptor instead.
+func (*SetKeepLocalResponse) Descriptor() ([]byte, []int) {
+	return file_card_card_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetKeepLocalResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+var File_card_card_proto protoreflect.FileDescriptor
+
+const file_card_card_proto_rawDesc = "" +
+	"\n" +
+	"\x0fcard/card.proto\x12\x04card\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x88\t\n" +
+	"\x04Card\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04word\x18\x04 \x01(\tR\x04word\x12 \n" +
+	"\vtranslation\x18\x05 \x01(\tR\vtranslation\x12\x1a\n" +
+	"\beasiness\x18\x06 \x01(\x01R\beasiness\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\binterval\x18\b \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12+\n" +
+	"\x11repetition_number\x18\n" +
+	" \x01(\x05R\x10repetitionNumber\x12\x17\n" +
+	"\adeck_id\x18\v \x01(\tR\x06deckId\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1b\n" +
+	"\tis_public\x18\r \x01(\bR\bisPublic\x12\x1c\n" +
+	"\tstability\x18\x0e \x01(\x01R\tstability\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x0f \x01(\x01R\n" +
+	"difficulty\x12\x16\n" +
+	"\x06lapses\x18\x10 \x01(\x05R\x06lapses\x12D\n" +
+	"\x10last_reviewed_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReviewedAt\x12\x1c\n" +
+	"\talgorithm\x18\x12 \x01(\tR\talgorithm\x12\x14\n" +
+	"\x05phase\x18\x13 \x01(\tR\x05phase\x12\x12\n" +
+	"\x04step\x18\x14 \x01(\x05R\x04step\x129\n" +
+	"\n" +
+	"deleted_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04rank\x18\x16 \x01(\x02R\x04rank\x12%\n" +
+	"\x0eword_highlight\x18\x17 \x01(\tR\rwordHighlight\x123\n" +
+	"\x15translation_highlight\x18\x18 \x01(\tR\x14translationHighlight\x12$\n" +
+	"\x0esource_card_id\x18\x19 \x01(\tR\fsourceCardId\x12\x1d\n" +
+	"\n" +
+	"keep_local\x18\x1a \x01(\bR\tkeepLocal\x12\x1a\n" +
+	"\btemplate\x18\x1b \x01(\tR\btemplate\x12\x17\n" +
+	"\anote_id\x18\x1c \x01(\tR\x06noteId\x12=\n" +
+	"\fburied_until\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\vburiedUntil\x12&\n" +
+	"\bsiblings\x18\x1e \x03(\v2\n" +
+	".card.CardR\bsiblings\x12\x18\n" +
+	"\aordinal\x18\x1f \x01(\x05R\aordinal\x12\x14\n" +
+	"\x05front\x18  \x01(\tR\x05front\x12\x12\n" +
+	"\x04back\x18! \x01(\tR\x04back\"0\n" +
+	"\x0eAddCardRequest\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card\"1\n" +
+	"\x0fAddCardResponse\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card\"B\n" +
+	"\x15ReadStudyQueueRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"?\n" +
+	"\x1bReadAllCardsToLearnResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\";\n" +
+	"\vPageRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"X\n" +
+	"\x16ReadAllOwnCardsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\"\\\n" +
+	"\x17ReadAllOwnCardsResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"^\n" +
+	"\x18SearchPublicCardsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"a\n" +
+	"\x1cSearchAllPublicCardsResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"{\n" +
+	"\x1cSearchUserPublicCardsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\"b\n" +
+	"\x1dSearchUserPublicCardsResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x87\x03\n" +
+	"\x11UpdateCardRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12 \n" +
+	"\vtranslation\x18\x03 \x01(\tR\vtranslation\x12\x1a\n" +
+	"\beasiness\x18\x04 \x01(\x01R\beasiness\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12+\n" +
+	"\x11repetition_number\x18\b \x01(\x05R\x10repetitionNumber\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x17\n" +
+	"\auser_id\x18\n" +
+	" \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_public\x18\v \x01(\bR\bisPublic\"4\n" +
+	"\x12UpdateCardResponse\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card\",\n" +
+	"\x11DeleteCardRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\".\n" +
+	"\x12DeleteCardResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
+	"\x14TrashedCardsResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\"-\n" +
+	"\x12RestoreCardRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\"5\n" +
+	"\x13RestoreCardResponse\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card\"\xa7\x01\n" +
+	"\x17ReleaseDeckCardsRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12$\n" +
+	"\x0etarget_deck_id\x18\x03 \x01(\tR\ftargetDeckId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\";\n" +
+	"\x18ReleaseDeckCardsResponse\x12\x1f\n" +
+	"\vcards_count\x18\x01 \x01(\x05R\n" +
+	"cardsCount\"V\n" +
+	"\x15CloneDeckCardsRequest\x12$\n" +
+	"\x0esource_deck_id\x18\x01 \x01(\tR\fsourceDeckId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\"9\n" +
+	"\x16CloneDeckCardsResponse\x12\x1f\n" +
+	"\vcards_count\x18\x01 \x01(\x05R\n" +
+	"cardsCount\"]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xd2\x02\n" +
+	"\fCardRevision\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x1b\n" +
+	"\tedited_by\x18\x03 \x01(\tR\beditedBy\x127\n" +
+	"\tedited_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12+\n" +
+	"\achanges\x18\x05 \x03(\v2\x11.card.FieldChangeR\achanges\x12#\n" +
+	"\rreverted_from\x18\x06 \x01(\x05R\frevertedFrom\x12\x12\n" +
+	"\x04word\x18\a \x01(\tR\x04word\x12 \n" +
+	"\vtranslation\x18\b \x01(\tR\vtranslation\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1b\n" +
+	"\tis_public\x18\n" +
+	" \x01(\bR\bisPublic\"1\n" +
+	"\x16ReadCardHistoryRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\"K\n" +
+	"\x17ReadCardHistoryResponse\x120\n" +
+	"\trevisions\x18\x01 \x03(\v2\x12.card.CardRevisionR\trevisions\"H\n" +
+	"\x11RevertCardRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"4\n" +
+	"\x12RevertCardResponse\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card\"\x93\x01\n" +
+	"\x06Answer\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x05R\x05grade\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12;\n" +
+	"\vanswered_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"answeredAt\"\xc3\x01\n" +
+	"\fAnswerResult\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1b\n" +
+	"\treview_id\x18\x03 \x01(\tR\breviewId\x12@\n" +
+	"\x0enext_review_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fnextReviewAt\x12\x1c\n" +
+	"\tduplicate\x18\x05 \x01(\bR\tduplicate\";\n" +
+	"\x11AddAnswersRequest\x12&\n" +
+	"\aanswers\x18\x01 \x03(\v2\f.card.AnswerR\aanswers\"<\n" +
+	"\x12SyncAnswersRequest\x12&\n" +
+	"\aanswers\x18\x01 \x03(\v2\f.card.AnswerR\aanswers\"^\n" +
+	"\fSyncConflict\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x97\x01\n" +
+	"\x13SyncAnswersResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\x12,\n" +
+	"\aresults\x18\x02 \x03(\v2\x12.card.AnswerResultR\aresults\x120\n" +
+	"\tconflicts\x18\x03 \x03(\v2\x12.card.SyncConflictR\tconflicts\"8\n" +
+	"\x16UndoLastAnswerResponse\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card\"\\\n" +
+	"\x12AddAnswersResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12,\n" +
+	"\aresults\x18\x02 \x03(\v2\x12.card.AnswerResultR\aresults\"\x7f\n" +
+	"\vPreferences\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"8\n" +
+	"\x18UpdatePreferencesRequest\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\"J\n" +
+	"\x13PreferencesResponse\x123\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x11.card.PreferencesR\vpreferences\"X\n" +
+	"\x11RetagCardsRequest\x12\x19\n" +
+	"\bcard_ids\x18\x01 \x03(\tR\acardIds\x12\x10\n" +
+	"\x03add\x18\x02 \x03(\tR\x03add\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\"6\n" +
+	"\x12RetagCardsResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\"2\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"6\n" +
+	"\x10ReadTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.card.TagCountR\x04tags\"?\n" +
+	"\x10RenameTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\"4\n" +
+	"\x11RenameTagResponse\x12\x1f\n" +
+	"\vcards_count\x18\x01 \x01(\x03R\n" +
+	"cardsCount\"\xc3\x01\n" +
+	"\tImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12 \n" +
+	"\vtranslation\x18\x03 \x01(\tR\vtranslation\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x120\n" +
+	"\bschedule\x18\x05 \x01(\v2\x14.card.ImportScheduleR\bschedule\x12&\n" +
+	"\areviews\x18\x06 \x03(\v2\f.card.ReviewR\areviews\"\xe2\x02\n" +
+	"\x0eImportSchedule\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x05R\binterval\x12\x1a\n" +
+	"\beasiness\x18\x03 \x01(\x01R\beasiness\x12+\n" +
+	"\x11repetition_number\x18\x04 \x01(\x05R\x10repetitionNumber\x12\x16\n" +
+	"\x06lapses\x18\x05 \x01(\x05R\x06lapses\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12D\n" +
+	"\x10last_reviewed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReviewedAt\x12\x1c\n" +
+	"\tstability\x18\b \x01(\x01R\tstability\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\t \x01(\x01R\n" +
+	"difficulty\"R\n" +
+	"\x12ImportCardsRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12#\n" +
+	"\x04rows\x18\x02 \x03(\v2\x0f.card.ImportRowR\x04rows\":\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x7f\n" +
+	"\x13ImportCardsResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\x05R\n" +
+	"duplicates\x12,\n" +
+	"\x06errors\x18\x03 \x03(\v2\x14.card.ImportRowErrorR\x06errors\"\x80\x02\n" +
+	"\x06Review\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x05R\x05grade\x12;\n" +
+	"\vreviewed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\x05R\binterval\x12\x1a\n" +
+	"\beasiness\x18\x06 \x01(\x01R\beasiness\x12\x1c\n" +
+	"\tstability\x18\a \x01(\x01R\tstability\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\b \x01(\x01R\n" +
+	"difficulty\"1\n" +
+	"\x16ReadDeckReviewsRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\"A\n" +
+	"\x17ReadDeckReviewsResponse\x12&\n" +
+	"\areviews\x18\x01 \x03(\v2\f.card.ReviewR\areviews\"-\n" +
+	"\x12PublishDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\"w\n" +
+	"\x13PublishDeckResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x16\n" +
+	"\x06edited\x18\x03 \x01(\x05R\x06edited\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\x05R\adeleted\"\xaf\x02\n" +
+	"\n" +
+	"DeckChange\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x17\n" +
+	"\acard_id\x18\x02 \x01(\tR\x06cardId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x12\n" +
+	"\x04word\x18\x04 \x01(\tR\x04word\x12 \n" +
+	"\vtranslation\x18\x05 \x01(\tR\vtranslation\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12=\n" +
+	"\fpublished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1a\n" +
+	"\btemplate\x18\b \x01(\tR\btemplate\x12\x17\n" +
+	"\anote_id\x18\t \x01(\tR\x06noteId\x12\x18\n" +
+	"\aordinal\x18\n" +
+	" \x01(\x05R\aordinal\"V\n" +
+	"\x16ReadDeckChangesRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12#\n" +
+	"\rsince_version\x18\x02 \x01(\x05R\fsinceVersion\"_\n" +
+	"\x17ReadDeckChangesResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12*\n" +
+	"\achanges\x18\x02 \x03(\v2\x10.card.DeckChangeR\achanges\"*\n" +
+	"\x0fSyncDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\"\x8a\x01\n" +
+	"\x10SyncDeckResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\x05R\adeleted\x12\x12\n" +
+	"\x04kept\x18\x05 \x01(\x05R\x04kept\"M\n" +
+	"\x13SetKeepLocalRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1d\n" +
+	"\n" +
+	"keep_local\x18\x02 \x01(\bR\tkeepLocal\"6\n" +
+	"\x14SetKeepLocalResponse\x12\x1e\n" +
+	"\x04card\x18\x01 \x01(\v2\n" +
+	".card.CardR\x04card2\x83\x10\n" +
+	"\vCardService\x126\n" +
+	"\aAddCard\x12\x14.card.AddCardRequest\x1a\x15.card.AddCardResponse\x12S\n" +
+	"\x16ReadAllOwnCardsToLearn\x12\x16.google.protobuf.Empty\x1a!.card.ReadAllCardsToLearnResponse\x12P\n" +
+	"\x0eReadStudyQueue\x12\x1b.card.ReadStudyQueueRequest\x1a!.card.ReadAllCardsToLearnResponse\x12N\n" +
+	"\x0fReadAllOwnCards\x12\x1c.card.ReadAllOwnCardsRequest\x1a\x1d.card.ReadAllOwnCardsResponse\x12Z\n" +
+	"\x14SearchAllPublicCards\x12\x1e.card.SearchPublicCardsRequest\x1a\".card.SearchAllPublicCardsResponse\x12`\n" +
+	"\x15SearchUserPublicCards\x12\".card.SearchUserPublicCardsRequest\x1a#.card.SearchUserPublicCardsResponse\x12?\n" +
+	"\n" +
+	"UpdateCard\x12\x17.card.UpdateCardRequest\x1a\x18.card.UpdateCardResponse\x12?\n" +
+	"\n" +
+	"DeleteCard\x12\x17.card.DeleteCardRequest\x1a\x18.card.DeleteCardResponse\x12F\n" +
+	"\x10ReadTrashedCards\x12\x16.google.protobuf.Empty\x1a\x1a.card.TrashedCardsResponse\x12B\n" +
+	"\vRestoreCard\x12\x18.card.RestoreCardRequest\x1a\x19.card.RestoreCardResponse\x12Q\n" +
+	"\x10ReleaseDeckCards\x12\x1d.card.ReleaseDeckCardsRequest\x1a\x1e.card.ReleaseDeckCardsResponse\x12K\n" +
+	"\x0eCloneDeckCards\x12\x1b.card.CloneDeckCardsRequest\x1a\x1c.card.CloneDeckCardsResponse\x12N\n" +
+	"\x0fReadCardHistory\x12\x1c.card.ReadCardHistoryRequest\x1a\x1d.card.ReadCardHistoryResponse\x12?\n" +
+	"\n" +
+	"RevertCard\x12\x17.card.RevertCardRequest\x1a\x18.card.RevertCardResponse\x12?\n" +
+	"\n" +
+	"AddAnswers\x12\x17.card.AddAnswersRequest\x1a\x18.card.AddAnswersResponse\x12F\n" +
+	"\x0eUndoLastAnswer\x12\x16.google.protobuf.Empty\x1a\x1c.card.UndoLastAnswerResponse\x12B\n" +
+	"\vSyncAnswers\x12\x18.card.SyncAnswersRequest\x1a\x19.card.SyncAnswersResponse\x12D\n" +
+	"\x0fReadPreferences\x12\x16.google.protobuf.Empty\x1a\x19.card.PreferencesResponse\x12N\n" +
+	"\x11UpdatePreferences\x12\x1e.card.UpdatePreferencesRequest\x1a\x19.card.PreferencesResponse\x12?\n" +
+	"\n" +
+	"RetagCards\x12\x17.card.RetagCardsRequest\x1a\x18.card.RetagCardsResponse\x12:\n" +
+	"\bReadTags\x12\x16.google.protobuf.Empty\x1a\x16.card.ReadTagsResponse\x12<\n" +
+	"\tRenameTag\x12\x16.card.RenameTagRequest\x1a\x17.card.RenameTagResponse\x12D\n" +
+	"\vImportCards\x12\x18.card.ImportCardsRequest\x1a\x19.card.ImportCardsResponse(\x01\x12N\n" +
+	"\x0fReadDeckReviews\x12\x1c.card.ReadDeckReviewsRequest\x1a\x1d.card.ReadDeckReviewsResponse\x12B\n" +
+	"\vPublishDeck\x12\x18.card.PublishDeckRequest\x1a\x19.card.PublishDeckResponse\x12N\n" +
+	"\x0fReadDeckChanges\x12\x1c.card.ReadDeckChangesRequest\x1a\x1d.card.ReadDeckChangesResponse\x129\n" +
+	"\bSyncDeck\x12\x15.card.SyncDeckRequest\x1a\x16.card.SyncDeckResponse\x12E\n" +
+	"\fSetKeepLocal\x12\x19.card.SetKeepLocalRequest\x1a\x1a.card.SetKeepLocalResponseB7Z5github.com/GOeda-Co/proto-contract/gen/go/card;cardv1b\x06proto3"
+
+var (
+	file_card_card_proto_rawDescOnce sync.Once
+	file_card_card_proto_rawDescData []byte
+)
+
+func file_card_card_proto_rawDescGZIP() []byte {
+	file_card_card_proto_rawDescOnce.Do(func() {
+		file_card_card_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_card_card_proto_rawDesc), len(file_card_card_proto_rawDesc)))
+	})
+	return file_card_card_proto_rawDescData
+}
+
+var file_card_card_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_card_card_proto_goTypes = []any{
+	(*Card)(nil),                          // 0: card.Card
+	(*AddCardRequest)(nil),                // 1: card.AddCardRequest
+	(*AddCardResponse)(nil),               // 2: card.AddCardResponse
+	(*ReadStudyQueueRequest)(nil),         // 3: card.ReadStudyQueueRequest
+	(*ReadAllCardsToLearnResponse)(nil),   // 4: card.ReadAllCardsToLearnResponse
+	(*PageRequest)(nil),                   // 5: card.PageRequest
+	(*ReadAllOwnCardsRequest)(nil),        // 6: card.ReadAllOwnCardsRequest
+	(*ReadAllOwnCardsResponse)(nil),       // 7: card.ReadAllOwnCardsResponse
+	(*SearchPublicCardsRequest)(nil),      // 8: card.SearchPublicCardsRequest
+	(*SearchAllPublicCardsResponse)(nil),  // 9: card.SearchAllPublicCardsResponse
+	(*SearchUserPublicCardsRequest)(nil),  // 10: card.SearchUserPublicCardsRequest
+	(*SearchUserPublicCardsResponse)(nil), // 11: card.SearchUserPublicCardsResponse
+	(*UpdateCardRequest)(nil),             // 12: card.UpdateCardRequest
+	(*UpdateCardResponse)(nil),            // 13: card.UpdateCardResponse
+	(*DeleteCardRequest)(nil),             // 14: card.DeleteCardRequest
+	(*DeleteCardResponse)(nil),            // 15: card.DeleteCardResponse
+	(*TrashedCardsResponse)(nil),          // 16: card.TrashedCardsResponse
+	(*RestoreCardRequest)(nil),            // 17: card.RestoreCardRequest
+	(*RestoreCardResponse)(nil),           // 18: card.RestoreCardResponse
+	(*ReleaseDeckCardsRequest)(nil),       // 19: card.ReleaseDeckCardsRequest
+	(*ReleaseDeckCardsResponse)(nil),      // 20: card.ReleaseDeckCardsResponse
+	(*CloneDeckCardsRequest)(nil),         // 21: card.CloneDeckCardsRequest
+	(*CloneDeckCardsResponse)(nil),        // 22: card.CloneDeckCardsResponse
+	(*FieldChange)(nil),                   // 23: card.FieldChange
+	(*CardRevision)(nil),                  // 24: card.CardRevision
+	(*ReadCardHistoryRequest)(nil),        // 25: card.ReadCardHistoryRequest
+	(*ReadCardHistoryResponse)(nil),       // 26: card.ReadCardHistoryResponse
+	(*RevertCardRequest)(nil),             // 27: card.RevertCardRequest
+	(*RevertCardResponse)(nil),            // 28: card.RevertCardResponse
+	(*Answer)(nil),                        // 29: card.Answer
+	(*AnswerResult)(nil),                  // 30: card.AnswerResult
+	(*AddAnswersRequest)(nil),             // 31: card.AddAnswersRequest
+	(*SyncAnswersRequest)(nil),            // 32: card.SyncAnswersRequest
+	(*SyncConflict)(nil),                  // 33: card.SyncConflict
+	(*SyncAnswersResponse)(nil),           // 34: card.SyncAnswersResponse
+	(*UndoLastAnswerResponse)(nil),        // 35: card.UndoLastAnswerResponse
+	(*AddAnswersResponse)(nil),            // 36: card.AddAnswersResponse
+	(*Preferences)(nil),                   // 37: card.Preferences
+	(*UpdatePreferencesRequest)(nil),      // 38: card.UpdatePreferencesRequest
+	(*PreferencesResponse)(nil),           // 39: card.PreferencesResponse
+	(*RetagCardsRequest)(nil),             // 40: card.RetagCardsRequest
+	(*RetagCardsResponse)(nil),            // 41: card.RetagCardsResponse
+	(*TagCount)(nil),                      // 42: card.TagCount
+	(*ReadTagsResponse)(nil),              // 43: card.ReadTagsResponse
+	(*RenameTagRequest)(nil),              // 44: card.RenameTagRequest
+	(*RenameTagResponse)(nil),             // 45: card.RenameTagResponse
+	(*ImportRow)(nil),                     // 46: card.ImportRow
+	(*ImportSchedule)(nil),                // 47: card.ImportSchedule
+	(*ImportCardsRequest)(nil),            // 48: card.ImportCardsRequest
+	(*ImportRowError)(nil),                // 49: card.ImportRowError
+	(*ImportCardsResponse)(nil),           // 50: card.ImportCardsResponse
+	(*Review)(nil),                        // 51: card.Review
+	(*ReadDeckReviewsRequest)(nil),        // 52: card.ReadDeckReviewsRequest
+	(*ReadDeckReviewsResponse)(nil),       // 53: card.ReadDeckReviewsResponse
+	(*PublishDeckRequest)(nil),            // 54: card.PublishDeckRequest
+	(*PublishDeckResponse)(nil),           // 55: card.PublishDeckResponse
+	(*DeckChange)(nil),                    // 56: card.DeckChange
+	(*ReadDeckChangesRequest)(nil),        // 57: card.ReadDeckChangesRequest
+	(*ReadDeckChangesResponse)(nil),       // 58: card.ReadDeckChangesResponse
+	(*SyncDeckRequest)(nil),               // 59: card.SyncDeckRequest
+	(*SyncDeckResponse)(nil),              // 60: card.SyncDeckResponse
+	(*SetKeepLocalRequest)(nil),           // 61: card.SetKeepLocalRequest
+	(*SetKeepLocalResponse)(nil),          // 62: card.SetKeepLocalResponse
+	(*timestamppb.Timestamp)(nil),         // 63: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 64: google.protobuf.Empty
+}
+var file_card_card_proto_depIdxs = []int32{
+	63, // 0: card.Card.created_at:type_name -> google.protobuf.Timestamp
+	63, // 1: card.Card.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 2: card.Card.expires_at:type_name -> google.protobuf.Timestamp
+	63, // 3: card.Card.last_reviewed_at:type_name -> google.protobuf.Timestamp
+	63, // 4: card.Card.deleted_at:type_name -> google.protobuf.Timestamp
+	63, // 5: card.Card.buried_until:type_name -> google.protobuf.Timestamp
+	0,  // 6: card.Card.siblings:type_name -> card.Card
+	0,  // 7: card.AddCardRequest.card:type_name -> card.Card
+	0,  // 8: card.AddCardResponse.card:type_name -> card.Card
+	0,  // 9: card.ReadAllCardsToLearnResponse.cards:type_name -> card.Card
+	0,  // 10: card.ReadAllOwnCardsResponse.cards:type_name -> card.Card
+	0,  // 11: card.SearchAllPublicCardsResponse.cards:type_name -> card.Card
+	0,  // 12: card.SearchUserPublicCardsResponse.cards:type_name -> card.Card
+	63, // 13: card.UpdateCardRequest.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 14: card.UpdateCardRequest.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 15: card.UpdateCardResponse.card:type_name -> card.Card
+	0,  // 16: card.TrashedCardsResponse.cards:type_name -> card.Card
+	0,  // 17: card.RestoreCardResponse.card:type_name -> card.Card
+	63, // 18: card.ReleaseDeckCardsRequest.deleted_at:type_name -> google.protobuf.Timestamp
+	63, // 19: card.CardRevision.edited_at:type_name -> google.protobuf.Timestamp
+	23, // 20: card.CardRevision.changes:type_name -> card.FieldChange
+	24, // 21: card.ReadCardHistoryResponse.revisions:type_name -> card.CardRevision
+	0,  // 22: card.RevertCardResponse.card:type_name -> card.Card
+	63, // 23: card.Answer.answered_at:type_name -> google.protobuf.Timestamp
+	63, // 24: card.AnswerResult.next_review_at:type_name -> google.protobuf.Timestamp
+	29, // 25: card.AddAnswersRequest.answers:type_name -> card.Answer
+	29, // 26: card.SyncAnswersRequest.answers:type_name -> card.Answer
+	0,  // 27: card.SyncAnswersResponse.cards:type_name -> card.Card
+	30, // 28: card.SyncAnswersResponse.results:type_name -> card.AnswerResult
+	33, // 29: card.SyncAnswersResponse.conflicts:type_name -> card.SyncConflict
+	0,  // 30: card.UndoLastAnswerResponse.card:type_name -> card.Card
+	30, // 31: card.AddAnswersResponse.results:type_name -> card.AnswerResult
+	63, // 32: card.Preferences.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 33: card.PreferencesResponse.preferences:type_name -> card.Preferences
+	0,  // 34: card.RetagCardsResponse.cards:type_name -> card.Card
+	42, // 35: card.ReadTagsResponse.tags:type_name -> card.TagCount
+	47, // 36: card.ImportRow.schedule:type_name -> card.ImportSchedule
+	51, // 37: card.ImportRow.reviews:type_name -> card.Review
+	63, // 38: card.ImportSchedule.expires_at:type_name -> google.protobuf.Timestamp
+	63, // 39: card.ImportSchedule.last_reviewed_at:type_name -> google.protobuf.Timestamp
+	46, // 40: card.ImportCardsRequest.rows:type_name -> card.ImportRow
+	49, // 41: card.ImportCardsResponse.errors:type_name -> card.ImportRowError
+	63, // 42: card.Review.reviewed_at:type_name -> google.protobuf.Timestamp
+	51, // 43: card.ReadDeckReviewsResponse.reviews:type_name -> card.Review
+	63, // 44: card.DeckChange.published_at:type_name -> google.protobuf.Timestamp
+	56, // 45: card.ReadDeckChangesResponse.changes:type_name -> card.DeckChange
+	0,  // 46: card.SetKeepLocalResponse.card:type_name -> card.Card
+	1,  // 47: card.CardService.AddCard:input_type -> card.AddCardRequest
+	64, // 48: card.CardService.ReadAllOwnCardsToLearn:input_type -> google.protobuf.Empty
+	3,  // 49: card.CardService.ReadStudyQueue:input_type -> card.ReadStudyQueueRequest
+	6,  // 50: card.CardService.ReadAllOwnCards:input_type -> card.ReadAllOwnCardsRequest
+	8,  // 51: card.CardService.SearchAllPublicCards:input_type -> card.SearchPublicCardsRequest
+	10, // 52: card.CardService.SearchUserPublicCards:input_type -> card.SearchUserPublicCardsRequest
+	12, // 53: card.CardService.UpdateCard:input_type -> card.UpdateCardRequest
+	14, // 54: card.CardService.DeleteCard:input_type -> card.DeleteCardRequest
+	64, // 55: card.CardService.ReadTrashedCards:input_type -> google.protobuf.Empty
+	17, // 56: card.CardService.RestoreCard:input_type -> card.RestoreCardRequest
+	19, // 57: card.CardService.ReleaseDeckCards:input_type -> card.ReleaseDeckCardsRequest
+	21, // 58: card.CardService.CloneDeckCards:input_type -> card.CloneDeckCardsRequest
+	25, // 59: card.CardService.ReadCardHistory:input_type -> card.ReadCardHistoryRequest
+	27, // 60: card.CardService.RevertCard:input_type -> card.RevertCardRequest
+	31, // 61: card.CardService.AddAnswers:input_type -> card.AddAnswersRequest
+	64, // 62: card.CardService.UndoLastAnswer:input_type -> google.protobuf.Empty
+	32, // 63: card.CardService.SyncAnswers:input_type -> card.SyncAnswersRequest
+	64, // 64: card.CardService.ReadPreferences:input_type -> google.protobuf.Empty
+	38, // 65: card.CardService.UpdatePreferences:input_type -> card.UpdatePreferencesRequest
+	40, // 66: card.CardService.RetagCards:input_type -> card.RetagCardsRequest
+	64, // 67: card.CardService.ReadTags:input_type -> google.protobuf.Empty
+	44, // 68: card.CardService.RenameTag:input_type -> card.RenameTagRequest
+	48, // 69: card.CardService.ImportCards:input_type -> card.ImportCardsRequest
+	52, // 70: card.CardService.ReadDeckReviews:input_type -> card.ReadDeckReviewsRequest
+	54, // 71: card.CardService.PublishDeck:input_type -> card.PublishDeckRequest
+	57, // 72: card.CardService.ReadDeckChanges:input_type -> card.ReadDeckChangesRequest
+	59, // 73: card.CardService.SyncDeck:input_type -> card.SyncDeckRequest
+	61, // 74: card.CardService.SetKeepLocal:input_type -> card.SetKeepLocalRequest
+	2,  // 75: card.CardService.AddCard:output_type -> card.AddCardResponse
+	4,  // 76: card.CardService.ReadAllOwnCardsToLearn:output_type -> card.ReadAllCardsToLearnResponse
+	4,  // 77: card.CardService.ReadStudyQueue:output_type -> card.ReadAllCardsToLearnResponse
+	7,  // 78: card.CardService.ReadAllOwnCards:output_type -> card.ReadAllOwnCardsResponse
+	9,  // 79: card.CardService.SearchAllPublicCards:output_type -> card.SearchAllPublicCardsResponse
+	11, // 80: card.CardService.SearchUserPublicCards:output_type -> card.SearchUserPublicCardsResponse
+	13, // 81: card.CardService.UpdateCard:output_type -> card.UpdateCardResponse
+	15, // 82: card.CardService.DeleteCard:output_type -> card.DeleteCardResponse
+	16, // 83: card.CardService.ReadTrashedCards:output_type -> card.TrashedCardsResponse
+	18, // 84: card.CardService.RestoreCard:output_type -> card.RestoreCardResponse
+	20, // 85: card.CardService.ReleaseDeckCards:output_type -> card.ReleaseDeckCardsResponse
+	22, // 86: card.CardService.CloneDeckCards:output_type -> card.CloneDeckCardsResponse
+	26, // 87: card.CardService.ReadCardHistory:output_type -> card.ReadCardHistoryResponse
+	28, // 88: card.CardService.RevertCard:output_type -> card.RevertCardResponse
+	36, // 89: card.CardService.AddAnswers:output_type -> card.AddAnswersResponse
+	35, // 90: card.CardService.UndoLastAnswer:output_type -> card.UndoLastAnswerResponse
+	34, // 91: card.CardService.SyncAnswers:output_type -> card.SyncAnswersResponse
+	39, // 92: card.CardService.ReadPreferences:output_type -> card.PreferencesResponse
+	39, // 93: card.CardService.UpdatePreferences:output_type -> card.PreferencesResponse
+	41, // 94: card.CardService.RetagCards:output_type -> card.RetagCardsResponse
+	43, // 95: card.CardService.ReadTags:output_type -> card.ReadTagsResponse
+	45, // 96: card.CardService.RenameTag:output_type -> card.RenameTagResponse
+	50, // 97: card.CardService.ImportCards:output_type -> card.ImportCardsResponse
+	53, // 98: card.CardService.ReadDeckReviews:output_type -> card.ReadDeckReviewsResponse
+	55, // 99: card.CardService.PublishDeck:output_type -> card.PublishDeckResponse
+	58, // 100: card.CardService.ReadDeckChanges:output_type -> card.ReadDeckChangesResponse
+	60, // 101: card.CardService.SyncDeck:output_type -> card.SyncDeckResponse
+	62, // 102: card.CardService.SetKeepLocal:output_type -> card.SetKeepLocalResponse
+	75, // [75:103] is the sub-list for method output_type
+	47, // [47:75] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_card_card_proto_init() }
+func file_card_card_proto_init() {
+	if File_card_card_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_card_card_proto_rawDesc), len(file_card_card_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   63,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_card_card_proto_goTypes,
+		DependencyIndexes: file_card_card_proto_depIdxs,
+		MessageInfos:      file_card_card_proto_msgTypes,
+	}.Build()
+	File_card_card_proto = out.File
+	file_card_card_proto_goTypes = nil
+	file_card_card_proto_depIdxs = nil
+}
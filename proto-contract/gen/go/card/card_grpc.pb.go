@@ -0,0 +1,1204 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: card/card.proto
+
+package cardv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CardService_AddCard_FullMethodName                = "/card.CardService/AddCard"
+	CardService_ReadAllOwnCardsToLearn_FullMethodName = "/card.CardService/ReadAllOwnCardsToLearn"
+	CardService_ReadStudyQueue_FullMethodName         = "/card.CardService/ReadStudyQueue"
+	CardService_ReadAllOwnCards_FullMethodName        = "/card.CardService/ReadAllOwnCards"
+	CardService_SearchAllPublicCards_FullMethodName   = "/card.CardService/SearchAllPublicCards"
+	CardService_SearchUserPublicCards_FullMethodName  = "/card.CardService/SearchUserPublicCards"
+	CardService_UpdateCard_FullMethodName             = "/card.CardService/UpdateCard"
+	CardService_DeleteCard_FullMethodName             = "/card.CardService/DeleteCard"
+	CardService_ReadTrashedCards_FullMethodName       = "/card.CardService/ReadTrashedCards"
+	CardService_RestoreCard_FullMethodName            = "/card.CardService/RestoreCard"
+	CardService_ReleaseDeckCards_FullMethodName       = "/card.CardService/ReleaseDeckCards"
+	CardService_CloneDeckCards_FullMethodName         = "/card.CardService/CloneDeckCards"
+	CardService_ReadCardHistory_FullMethodName        = "/card.CardService/ReadCardHistory"
+	CardService_RevertCard_FullMethodName             = "/card.CardService/RevertCard"
+	CardService_AddAnswers_FullMethodName             = "/card.CardService/AddAnswers"
+	CardService_UndoLastAnswer_FullMethodName         = "/card.CardService/UndoLastAnswer"
+	CardService_SyncAnswers_FullMethodName            = "/card.CardService/SyncAnswers"
+	CardService_ReadPreferences_FullMethodName        = "/card.CardService/ReadPreferences"
+	CardService_UpdatePreferences_FullMethodName      = "/card.CardService/UpdatePreferences"
+	CardService_RetagCards_FullMethodName             = "/card.CardService/RetagCards"
+	CardService_ReadTags_FullMethodName               = "/card.CardService/ReadTags"
+	CardService_RenameTag_FullMethodName              = "/card.CardService/RenameTag"
+	CardService_ImportCards_FullMethodName            = "/card.CardService/ImportCards"
+	CardService_ReadDeckReviews_FullMethodName        = "/card.CardService/ReadDeckReviews"
+	CardService_PublishDeck_FullMethodName            = "/card.CardService/PublishDeck"
+	CardService_ReadDeckChanges_FullMethodName        = "/card.CardService/ReadDeckChanges"
+	CardService_SyncDeck_FullMethodName               = "/card.CardService/SyncDeck"
+	CardService_SetKeepLocal_FullMethodName           = "/card.CardService/SetKeepLocal"
+)
+
+// CardServiceClient is the client API for CardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The Card service provides endpoints for managing user-created cards
+type CardServiceClient interface {
+	AddCard(ctx context.Context, in *AddCardRequest, opts ...grpc.CallOption) (*AddCardResponse, error)
+	// This method shows all cards that are ready for learning (expires_time < time.now())
+	ReadAllOwnCardsToLearn(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadAllCardsToLearnResponse, error)
+	// Today's study queue: due learning cards first, then reviews interleaved with new cards
+	// within daily limits of every deck
+	ReadStudyQueue(ctx context.Context, in *ReadStudyQueueRequest, opts ...grpc.CallOption) (*ReadAllCardsToLearnResponse, error)
+	// This method shows cards that were created by user, newest first, one page at a time
+	ReadAllOwnCards(ctx context.Context, in *ReadAllOwnCardsRequest, opts ...grpc.CallOption) (*ReadAllOwnCardsResponse, error)
+	// Search all public cards, newest first, one page at a time.
+	// With a query only matching cards are returned, best matches first
+	SearchAllPublicCards(ctx context.Context, in *SearchPublicCardsRequest, opts ...grpc.CallOption) (*SearchAllPublicCardsResponse, error)
+	// Search public cards for a specific user
+	SearchUserPublicCards(ctx context.Context, in *SearchUserPublicCardsRequest, opts ...grpc.CallOption) (*SearchUserPublicCardsResponse, error)
+	UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*UpdateCardResponse, error)
+	// Moves the card to trash, it is purged after the retention period
+	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error)
+	// Cards of the user in trash, recently deleted first
+	ReadTrashedCards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TrashedCardsResponse, error)
+	RestoreCard(ctx context.Context, in *RestoreCardRequest, opts ...grpc.CallOption) (*RestoreCardResponse, error)
+	// Applies deletion of a deck to its cards: trashes them with the deck, moves them to
+	// another deck or detaches them. Called by deck service before it deletes the deck
+	ReleaseDeckCards(ctx context.Context, in *ReleaseDeckCardsRequest, opts ...grpc.CallOption) (*ReleaseDeckCardsResponse, error)
+	// Copies cards of a public or own deck into a deck of the user with fresh scheduling.
+	// Called by deck service when a deck is cloned
+	CloneDeckCards(ctx context.Context, in *CloneDeckCardsRequest, opts ...grpc.CallOption) (*CloneDeckCardsResponse, error)
+	// Edit history of a card, oldest revision first
+	ReadCardHistory(ctx context.Context, in *ReadCardHistoryRequest, opts ...grpc.CallOption) (*ReadCardHistoryResponse, error)
+	// Restores content of the card as it was at the revision, recorded as a new revision
+	RevertCard(ctx context.Context, in *RevertCardRequest, opts ...grpc.CallOption) (*RevertCardResponse, error)
+	AddAnswers(ctx context.Context, in *AddAnswersRequest, opts ...grpc.CallOption) (*AddAnswersResponse, error)
+	// Restores the card answered last to its state before the answer and removes the review
+	UndoLastAnswer(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UndoLastAnswerResponse, error)
+	// Replays answers given offline in the order they were given and returns resulting card states
+	SyncAnswers(ctx context.Context, in *SyncAnswersRequest, opts ...grpc.CallOption) (*SyncAnswersResponse, error)
+	// Scheduling preferences of the user (e.g. which algorithm reschedules answered cards)
+	ReadPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error)
+	// Adds and removes tags on own cards, one card or many at once
+	RetagCards(ctx context.Context, in *RetagCardsRequest, opts ...grpc.CallOption) (*RetagCardsResponse, error)
+	// Tags of the user with the number of cards having each, most used first
+	ReadTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadTagsResponse, error)
+	// Renames the tag on every card of the user, merging it into an existing tag of the new name
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// Bulk import of cards into a deck. Rows are streamed in batches, the first message names the deck.
+	// Rows may carry scheduling state of the app they come from
+	ImportCards(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCardsRequest, ImportCardsResponse], error)
+	// Review history of cards in an own deck, oldest first
+	ReadDeckReviews(ctx context.Context, in *ReadDeckReviewsRequest, opts ...grpc.CallOption) (*ReadDeckReviewsResponse, error)
+	// Records changes of cards in an own deck since its last version as a new version
+	PublishDeck(ctx context.Context, in *PublishDeckRequest, opts ...grpc.CallOption) (*PublishDeckResponse, error)
+	// Changelog of a public or own deck after the given version, oldest first
+	ReadDeckChanges(ctx context.Context, in *ReadDeckChangesRequest, opts ...grpc.CallOption) (*ReadDeckChangesResponse, error)
+	// Merges changes of the source deck published since the last sync into a subscribed copy.
+	// Scheduling of the copied cards is kept
+	SyncDeck(ctx context.Context, in *SyncDeckRequest, opts ...grpc.CallOption) (*SyncDeckResponse, error)
+	// Stops or resumes merging changes of the source card into a cloned card
+	SetKeepLocal(ctx context.Context, in *SetKeepLocalRequest, opts ...grpc.CallOption) (*SetKeepLocalResponse, error)
+}
+
+type cardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCardServiceClient(cc grpc.ClientConnInterface) CardServiceClient {
+	return &cardServiceClient{cc}
+}
+
+func (c *cardServiceClient) AddCard(ctx context.Context, in *AddCardRequest, opts ...grpc.CallOption) (*AddCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCardResponse)
+	err := c.cc.Invoke(ctx, CardService_AddCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadAllOwnCardsToLearn(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadAllCardsToLearnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadAllCardsToLearnResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadAllOwnCardsToLearn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadStudyQueue(ctx context.Context, in *ReadStudyQueueRequest, opts ...grpc.CallOption) (*ReadAllCardsToLearnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadAllCardsToLearnResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadStudyQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadAllOwnCards(ctx context.Context, in *ReadAllOwnCardsRequest, opts ...grpc.CallOption) (*ReadAllOwnCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadAllOwnCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadAllOwnCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SearchAllPublicCards(ctx context.Context, in *SearchPublicCardsRequest, opts ...grpc.CallOption) (*SearchAllPublicCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAllPublicCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_SearchAllPublicCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SearchUserPublicCards(ctx context.Context, in *SearchUserPublicCardsRequest, opts ...grpc.CallOption) (*SearchUserPublicCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUserPublicCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_SearchUserPublicCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*UpdateCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCardResponse)
+	err := c.cc.Invoke(ctx, CardService_UpdateCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCardResponse)
+	err := c.cc.Invoke(ctx, CardService_DeleteCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadTrashedCards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TrashedCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrashedCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadTrashedCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RestoreCard(ctx context.Context, in *RestoreCardRequest, opts ...grpc.CallOption) (*RestoreCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCardResponse)
+	err := c.cc.Invoke(ctx, CardService_RestoreCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReleaseDeckCards(ctx context.Context, in *ReleaseDeckCardsRequest, opts ...grpc.CallOption) (*ReleaseDeckCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseDeckCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_ReleaseDeckCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) CloneDeckCards(ctx context.Context, in *CloneDeckCardsRequest, opts ...grpc.CallOption) (*CloneDeckCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneDeckCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_CloneDeckCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadCardHistory(ctx context.Context, in *ReadCardHistoryRequest, opts ...grpc.CallOption) (*ReadCardHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadCardHistoryResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadCardHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RevertCard(ctx context.Context, in *RevertCardRequest, opts ...grpc.CallOption) (*RevertCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertCardResponse)
+	err := c.cc.Invoke(ctx, CardService_RevertCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) AddAnswers(ctx context.Context, in *AddAnswersRequest, opts ...grpc.CallOption) (*AddAnswersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAnswersResponse)
+	err := c.cc.Invoke(ctx, CardService_AddAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) UndoLastAnswer(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UndoLastAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoLastAnswerResponse)
+	err := c.cc.Invoke(ctx, CardService_UndoLastAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SyncAnswers(ctx context.Context, in *SyncAnswersRequest, opts ...grpc.CallOption) (*SyncAnswersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncAnswersResponse)
+	err := c.cc.Invoke(ctx, CardService_SyncAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*PreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreferencesResponse)
+	err := c.cc.Invoke(ctx, CardService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RetagCards(ctx context.Context, in *RetagCardsRequest, opts ...grpc.CallOption) (*RetagCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetagCardsResponse)
+	err := c.cc.Invoke(ctx, CardService_RetagCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadTagsResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, CardService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ImportCards(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCardsRequest, ImportCardsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CardService_ServiceDesc.Streams[0], CardService_ImportCards_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportCardsRequest, ImportCardsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CardService_ImportCardsClient = grpc.ClientStreamingClient[ImportCardsRequest, ImportCardsResponse]
+
+func (c *cardServiceClient) ReadDeckReviews(ctx context.Context, in *ReadDeckReviewsRequest, opts ...grpc.CallOption) (*ReadDeckReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadDeckReviewsResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadDeckReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) PublishDeck(ctx context.Context, in *PublishDeckRequest, opts ...grpc.CallOption) (*PublishDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishDeckResponse)
+	err := c.cc.Invoke(ctx, CardService_PublishDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ReadDeckChanges(ctx context.Context, in *ReadDeckChangesRequest, opts ...grpc.CallOption) (*ReadDeckChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadDeckChangesResponse)
+	err := c.cc.Invoke(ctx, CardService_ReadDeckChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SyncDeck(ctx context.Context, in *SyncDeckRequest, opts ...grpc.CallOption) (*SyncDeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncDeckResponse)
+	err := c.cc.Invoke(ctx, CardService_SyncDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SetKeepLocal(ctx context.Context, in *SetKeepLocalRequest, opts ...grpc.CallOption) (*SetKeepLocalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetKeepLocalResponse)
+	err := c.cc.Invoke(ctx, CardService_SetKeepLocal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CardServiceServer is the server API for CardService service.
+// All implementations must embed UnimplementedCardServiceServer
+// for forward compatibility.
+//
+// The Card service provides endpoints for managing user-created cards
+type CardServiceServer interface {
+	AddCard(context.Context, *AddCardRequest) (*AddCardResponse, error)
+	// This method shows all cards that are ready for learning (expires_time < time.now())
+	ReadAllOwnCardsToLearn(context.Context, *emptypb.Empty) (*ReadAllCardsToLearnResponse, error)
+	// Today's study queue: due learning cards first, then reviews interleaved with new cards
+	// within daily limits of every deck
+	ReadStudyQueue(context.Context, *ReadStudyQueueRequest) (*ReadAllCardsToLearnResponse, error)
+	// This method shows cards that were created by user, newest first, one page at a time
+	ReadAllOwnCards(context.Context, *ReadAllOwnCardsRequest) (*ReadAllOwnCardsResponse, error)
+	// Search all public cards, newest first, one page at a time.
+	// With a query only matching cards are returned, best matches first
+	SearchAllPublicCards(context.Context, *SearchPublicCardsRequest) (*SearchAllPublicCardsResponse, error)
+	// Search public cards for a specific user
+	SearchUserPublicCards(context.Context, *SearchUserPublicCardsRequest) (*SearchUserPublicCardsResponse, error)
+	UpdateCard(context.Context, *UpdateCardRequest) (*UpdateCardResponse, error)
+	// Moves the card to trash, it is purged after the retention period
+	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
+	// Cards of the user in trash, recently deleted first
+	ReadTrashedCards(context.Context, *emptypb.Empty) (*TrashedCardsResponse, error)
+	RestoreCard(context.Context, *RestoreCardRequest) (*RestoreCardResponse, error)
+	// Applies deletion of a deck to its cards: trashes them with the deck, moves them to
+	// another deck or detaches them. Called by deck service before it deletes the deck
+	ReleaseDeckCards(context.Context, *ReleaseDeckCardsRequest) (*ReleaseDeckCardsResponse, error)
+	// Copies cards of a public or own deck into a deck of the user with fresh scheduling.
+	// Called by deck service when a deck is cloned
+	CloneDeckCards(context.Context, *CloneDeckCardsRequest) (*CloneDeckCardsResponse, error)
+	// Edit history of a card, oldest revision first
+	ReadCardHistory(context.Context, *ReadCardHistoryRequest) (*ReadCardHistoryResponse, error)
+	// Restores content of the card as it was at the revision, recorded as a new revision
+	RevertCard(context.Context, *RevertCardRequest) (*RevertCardResponse, error)
+	AddAnswers(context.Context, *AddAnswersRequest) (*AddAnswersResponse, error)
+	// Restores the card answered last to its state before the answer and removes the review
+	UndoLastAnswer(context.Context, *emptypb.Empty) (*UndoLastAnswerResponse, error)
+	// Replays answers given offline in the order they were given and returns resulting card states
+	SyncAnswers(context.Context, *SyncAnswersRequest) (*SyncAnswersResponse, error)
+	// Scheduling preferences of the user (e.g. which algorithm reschedules answered cards)
+	ReadPreferences(context.Context, *emptypb.Empty) (*PreferencesResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesResponse, error)
+	// Adds and removes tags on own cards, one card or many at once
+	RetagCards(context.Context, *RetagCardsRequest) (*RetagCardsResponse, error)
+	// Tags of the user with the number of cards having each, most used first
+	ReadTags(context.Context, *emptypb.Empty) (*ReadTagsResponse, error)
+	// Renames the tag on every card of the user, merging it into an existing tag of the new name
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// Bulk import of cards into a deck. Rows are streamed in batches, the first message names the deck.
+	// Rows may carry scheduling state of the app they come from
+	ImportCards(grpc.ClientStreamingServer[ImportCardsRequest, ImportCardsResponse]) error
+	// Review history of cards in an own deck, oldest first
+	ReadDeckReviews(context.Context, *ReadDeckReviewsRequest) (*ReadDeckReviewsResponse, error)
+	// Records changes of cards in an own deck since its last version as a new version
+	PublishDeck(context.Context, *PublishDeckRequest) (*PublishDeckResponse, error)
+	// Changelog of a public or own deck after the given version, oldest first
+	ReadDeckChanges(context.Context, *ReadDeckChangesRequest) (*ReadDeckChangesResponse, error)
+	// Merges changes of the source deck published since the last sync into a subscribed copy.
+	// Scheduling of the copied cards is kept
+	SyncDeck(context.Context, *SyncDeckRequest) (*SyncDeckResponse, error)
+	// Stops or resumes merging changes of the source card into a cloned card
+	SetKeepLocal(context.Context, *SetKeepLocalRequest) (*SetKeepLocalResponse, error)
+	mustEmbedUnimplementedCardServiceServer()
+}
+
+// UnimplementedCardServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCardServiceServer struct{}
+
+func (UnimplementedCardServiceServer) AddCard(context.Context, *AddCardRequest) (*AddCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCard not implemented")
+}
+func (UnimplementedCardServiceServer) ReadAllOwnCardsToLearn(context.Context, *emptypb.Empty) (*ReadAllCardsToLearnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllOwnCardsToLearn not implemented")
+}
+func (UnimplementedCardServiceServer) ReadStudyQueue(context.Context, *ReadStudyQueueRequest) (*ReadAllCardsToLearnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadStudyQueue not implemented")
+}
+func (UnimplementedCardServiceServer) ReadAllOwnCards(context.Context, *ReadAllOwnCardsRequest) (*ReadAllOwnCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllOwnCards not implemented")
+}
+func (UnimplementedCardServiceServer) SearchAllPublicCards(context.Context, *SearchPublicCardsRequest) (*SearchAllPublicCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAllPublicCards not implemented")
+}
+func (UnimplementedCardServiceServer) SearchUserPublicCards(context.Context, *SearchUserPublicCardsRequest) (*SearchUserPublicCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUserPublicCards not implemented")
+}
+func (UnimplementedCardServiceServer) UpdateCard(context.Context, *UpdateCardRequest) (*UpdateCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCard not implemented")
+}
+func (UnimplementedCardServiceServer) DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
+}
+func (UnimplementedCardServiceServer) ReadTrashedCards(context.Context, *emptypb.Empty) (*TrashedCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTrashedCards not implemented")
+}
+func (UnimplementedCardServiceServer) RestoreCard(context.Context, *RestoreCardRequest) (*RestoreCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCard not implemented")
+}
+func (UnimplementedCardServiceServer) ReleaseDeckCards(context.Context, *ReleaseDeckCardsRequest) (*ReleaseDeckCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDeckCards not implemented")
+}
+func (UnimplementedCardServiceServer) CloneDeckCards(context.Context, *CloneDeckCardsRequest) (*CloneDeckCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneDeckCards not implemented")
+}
+func (UnimplementedCardServiceServer) ReadCardHistory(context.Context, *ReadCardHistoryRequest) (*ReadCardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadCardHistory not implemented")
+}
+func (UnimplementedCardServiceServer) RevertCard(context.Context, *RevertCardRequest) (*RevertCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCard not implemented")
+}
+func (UnimplementedCardServiceServer) AddAnswers(context.Context, *AddAnswersRequest) (*AddAnswersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAnswers not implemented")
+}
+func (UnimplementedCardServiceServer) UndoLastAnswer(context.Context, *emptypb.Empty) (*UndoLastAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoLastAnswer not implemented")
+}
+func (UnimplementedCardServiceServer) SyncAnswers(context.Context, *SyncAnswersRequest) (*SyncAnswersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncAnswers not implemented")
+}
+func (UnimplementedCardServiceServer) ReadPreferences(context.Context, *emptypb.Empty) (*PreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPreferences not implemented")
+}
+func (UnimplementedCardServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedCardServiceServer) RetagCards(context.Context, *RetagCardsRequest) (*RetagCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetagCards not implemented")
+}
+func (UnimplementedCardServiceServer) ReadTags(context.Context, *emptypb.Empty) (*ReadTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTags not implemented")
+}
+func (UnimplementedCardServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedCardServiceServer) ImportCards(grpc.ClientStreamingServer[ImportCardsRequest, ImportCardsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCards not implemented")
+}
+func (UnimplementedCardServiceServer) ReadDeckReviews(context.Context, *ReadDeckReviewsRequest) (*ReadDeckReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeckReviews not implemented")
+}
+func (UnimplementedCardServiceServer) PublishDeck(context.Context, *PublishDeckRequest) (*PublishDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDeck not implemented")
+}
+func (UnimplementedCardServiceServer) ReadDeckChanges(context.Context, *ReadDeckChangesRequest) (*ReadDeckChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeckChanges not implemented")
+}
+func (UnimplementedCardServiceServer) SyncDeck(context.Context, *SyncDeckRequest) (*SyncDeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncDeck not implemented")
+}
+func (UnimplementedCardServiceServer) SetKeepLocal(context.Context, *SetKeepLocalRequest) (*SetKeepLocalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeepLocal not implemented")
+}
+func (UnimplementedCardServiceServer) mustEmbedUnimplementedCardServiceServer() {}
+func (UnimplementedCardServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CardServiceServer will
+// result in compilation errors.
+type UnsafeCardServiceServer interface {
+	mustEmbedUnimplementedCardServiceServer()
+}
+
+func RegisterCardServiceServer(s grpc.ServiceRegistrar, srv CardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CardService_ServiceDesc, srv)
+}
+
+func _CardService_AddCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).AddCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_AddCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).AddCard(ctx, req.(*AddCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadAllOwnCardsToLearn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadAllOwnCardsToLearn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadAllOwnCardsToLearn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadAllOwnCardsToLearn(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadStudyQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStudyQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadStudyQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadStudyQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadStudyQueue(ctx, req.(*ReadStudyQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadAllOwnCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllOwnCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadAllOwnCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadAllOwnCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadAllOwnCards(ctx, req.(*ReadAllOwnCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SearchAllPublicCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPublicCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SearchAllPublicCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SearchAllPublicCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SearchAllPublicCards(ctx, req.(*SearchPublicCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SearchUserPublicCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserPublicCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SearchUserPublicCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SearchUserPublicCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SearchUserPublicCards(ctx, req.(*SearchUserPublicCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).UpdateCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_UpdateCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).UpdateCard(ctx, req.(*UpdateCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_DeleteCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).DeleteCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_DeleteCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).DeleteCard(ctx, req.(*DeleteCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadTrashedCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadTrashedCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadTrashedCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadTrashedCards(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RestoreCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RestoreCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_RestoreCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RestoreCard(ctx, req.(*RestoreCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReleaseDeckCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDeckCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReleaseDeckCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReleaseDeckCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReleaseDeckCards(ctx, req.(*ReleaseDeckCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_CloneDeckCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneDeckCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).CloneDeckCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_CloneDeckCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).CloneDeckCards(ctx, req.(*CloneDeckCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadCardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadCardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadCardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadCardHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadCardHistory(ctx, req.(*ReadCardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RevertCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RevertCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_RevertCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RevertCard(ctx, req.(*RevertCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_AddAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAnswersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).AddAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_AddAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).AddAnswers(ctx, req.(*AddAnswersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_UndoLastAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).UndoLastAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_UndoLastAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).UndoLastAnswer(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SyncAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncAnswersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SyncAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SyncAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SyncAnswers(ctx, req.(*SyncAnswersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadPreferences(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RetagCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetagCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RetagCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_RetagCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RetagCards(ctx, req.(*RetagCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadTags(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ImportCards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CardServiceServer).ImportCards(&grpc.GenericServerStream[ImportCardsRequest, ImportCardsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CardService_ImportCardsServer = grpc.ClientStreamingServer[ImportCardsRequest, ImportCardsResponse]
+
+func _CardService_ReadDeckReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadDeckReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadDeckReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadDeckReviews(ctx, req.(*ReadDeckReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_PublishDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).PublishDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_PublishDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).PublishDeck(ctx, req.(*PublishDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ReadDeckChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ReadDeckChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_ReadDeckChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ReadDeckChanges(ctx, req.(*ReadDeckChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SyncDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SyncDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SyncDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SyncDeck(ctx, req.(*SyncDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SetKeepLocal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeepLocalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SetKeepLocal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CardService_SetKeepLocal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SetKeepLocal(ctx, req.(*SetKeepLocalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CardService_ServiceDesc is the grpc.ServiceDesc for CardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "card.CardService",
+	HandlerType: (*CardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCard",
+			Handler:    _CardService_AddCard_Handler,
+		},
+		{
+			MethodName: "ReadAllOwnCardsToLearn",
+			Handler:    _CardService_ReadAllOwnCardsToLearn_Handler,
+		},
+		{
+			MethodName: "ReadStudyQueue",
+			Handler:    _CardService_ReadStudyQueue_Handler,
+		},
+		{
+			MethodName: "ReadAllOwnCards",
+			Handler:    _CardService_ReadAllOwnCards_Handler,
+		},
+		{
+			MethodName: "SearchAllPublicCards",
+			Handler:    _CardService_SearchAllPublicCards_Handler,
+		},
+		{
+			MethodName: "SearchUserPublicCards",
+			Handler:    _CardService_SearchUserPublicCards_Handler,
+		},
+		{
+			MethodName: "UpdateCard",
+			Handler:    _CardService_UpdateCard_Handler,
+		},
+		{
+			MethodName: "DeleteCard",
+			Handler:    _CardService_DeleteCard_Handler,
+		},
+		{
+			MethodName: "ReadTrashedCards",
+			Handler:    _CardService_ReadTrashedCards_Handler,
+		},
+		{
+			MethodName: "RestoreCard",
+			Handler:    _CardService_RestoreCard_Handler,
+		},
+		{
+			MethodName: "ReleaseDeckCards",
+			Handler:    _CardService_ReleaseDeckCards_Handler,
+		},
+		{
+			MethodName: "CloneDeckCards",
+			Handler:    _CardService_CloneDeckCards_Handler,
+		},
+		{
+			MethodName: "ReadCardHistory",
+			Handler:    _CardService_ReadCardHistory_Handler,
+		},
+		{
+			MethodName: "RevertCard",
+			Handler:    _CardService_RevertCard_Handler,
+		},
+		{
+			MethodName: "AddAnswers",
+			Handler:    _CardService_AddAnswers_Handler,
+		},
+		{
+			MethodName: "UndoLastAnswer",
+			Handler:    _CardService_UndoLastAnswer_Handler,
+		},
+		{
+			MethodName: "SyncAnswers",
+			Handler:    _CardService_SyncAnswers_Handler,
+		},
+		{
+			MethodName: "ReadPreferences",
+			Handler:    _CardService_ReadPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _CardService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "RetagCards",
+			Handler:    _CardService_RetagCards_Handler,
+		},
+		{
+			MethodName: "ReadTags",
+			Handler:    _CardService_ReadTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _CardService_RenameTag_Handler,
+		},
+		{
+			MethodName: "ReadDeckReviews",
+			Handler:    _CardService_ReadDeckReviews_Handler,
+		},
+		{
+			MethodName: "PublishDeck",
+			Handler:    _CardService_PublishDeck_Handler,
+		},
+		{
+			MethodName: "ReadDeckChanges",
+			Handler:    _CardService_ReadDeckChanges_Handler,
+		},
+		{
+			MethodName: "SyncDeck",
+			Handler:    _CardService_SyncDeck_Handler,
+		},
+		{
+			MethodName: "SetKeepLocal",
+			Handler:    _CardService_SetKeepLocal_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCards",
+			Handler:       _CardService_ImportCards_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "card/card.proto",
+}
@@ -0,0 +1,2119 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: deck/deck.proto
+
+package deckv1
+
+import (
+	card "github.com/GOeda-Co/proto-contract/gen/go/card"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,3,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	ParentDeckId  string                 `protobuf:"bytes,4,opt,name=parent_deck_id,json=parentDeckId,proto3" json:"parent_deck_id,omitempty"` // empty for a top-level deck
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDeckRequest) Reset() {
+	*x = AddDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDeckRequest) ProtoMessage() {}
+
+func (x *AddDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDeckRequest.ProtoReflect.Descriptor instead.
+func (*AddDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddDeckRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddDeckRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddDeckRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *AddDeckRequest) GetParentDeckId() string {
+	if x != nil {
+		return x.ParentDeckId
+	}
+	return ""
+}
+
+type ReadDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDeckRequest) Reset() {
+	*x = ReadDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeckRequest) ProtoMessage() {}
+
+func (x *ReadDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeckRequest.ProtoReflect.Descriptor instead.
+func (*ReadDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type UpdateDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsPublic      *bool                  `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeckRequest) Reset() {
+	*x = UpdateDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeckRequest) ProtoMessage() {}
+
+func (x *UpdateDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeckRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateDeckRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+type MoveDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	ParentDeckId  string                 `protobuf:"bytes,2,opt,name=parent_deck_id,json=parentDeckId,proto3" json:"parent_deck_id,omitempty"` // empty to make the deck top-level
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveDeckRequest) Reset() {
+	*x = MoveDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveDeckRequest) ProtoMessage() {}
+
+func (x *MoveDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveDeckRequest.ProtoReflect.Descriptor instead.
+func (*MoveDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MoveDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *MoveDeckRequest) GetParentDeckId() string {
+	if x != nil {
+		return x.ParentDeckId
+	}
+	return ""
+}
+
+type DeckDueCount struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DeckId       string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	ParentDeckId string                 `protobuf:"bytes,2,opt,name=parent_deck_id,json=parentDeckId,proto3" json:"parent_deck_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The deck alone
+	New      int32 `protobuf:"varint,4,opt,name=new,proto3" json:"new,omitempty"`
+	Learning int32 `protobuf:"varint,5,opt,name=learning,proto3" json:"learning,omitempty"`
+	Review   int32 `protobuf:"varint,6,opt,name=review,proto3" json:"review,omitempty"`
+	// The deck with all of its subdecks
+	TotalNew      int32 `protobuf:"varint,7,opt,name=total_new,json=totalNew,proto3" json:"total_new,omitempty"`
+	TotalLearning int32 `protobuf:"varint,8,opt,name=total_learning,json=totalLearning,proto3" json:"total_learning,omitempty"`
+	TotalReview   int32 `protobuf:"varint,9,opt,name=total_review,json=totalReview,proto3" json:"total_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckDueCount) Reset() {
+	*x = DeckDueCount{}
+	mi := &file_deck_deck_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckDueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckDueCount) ProtoMessage() {}
+
+func (x *DeckDueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckDueCount.ProtoReflect.Descriptor instead.
+func (*DeckDueCount) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeckDueCount) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *DeckDueCount) GetParentDeckId() string {
+	if x != nil {
+		return x.ParentDeckId
+	}
+	return ""
+}
+
+func (x *DeckDueCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeckDueCount) GetNew() int32 {
+	if x != nil {
+		return x.New
+	}
+	return 0
+}
+
+func (x *DeckDueCount) GetLearning() int32 {
+	if x != nil {
+		return x.Learning
+	}
+	return 0
+}
+
+func (x *DeckDueCount) GetReview() int32 {
+	if x != nil {
+		return x.Review
+	}
+	return 0
+}
+
+func (x *DeckDueCount) GetTotalNew() int32 {
+	if x != nil {
+		return x.TotalNew
+	}
+	return 0
+}
+
+func (x *DeckDueCount) GetTotalLearning() int32 {
+	if x != nil {
+		return x.TotalLearning
+	}
+	return 0
+}
+
+func (x *DeckDueCount) GetTotalReview() int32 {
+	if x != nil {
+		return x.TotalReview
+	}
+	return 0
+}
+
+type DeckDueCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []*DeckDueCount        `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"` // the requested deck first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckDueCountsResponse) Reset() {
+	*x = DeckDueCountsResponse{}
+	mi := &file_deck_deck_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckDueCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckDueCountsResponse) ProtoMessage() {}
+
+func (x *DeckDueCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckDueCountsResponse.ProtoReflect.Descriptor instead.
+func (*DeckDueCountsResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeckDueCountsResponse) GetCounts() []*DeckDueCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type DeleteDeckRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DeckId string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	// "delete" (default) - cards go to trash with the deck; "move" - cards are moved to target_deck_id;
+	// "detach" - cards stay without a deck
+	Mode          string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	TargetDeckId  string `protobuf:"bytes,3,opt,name=target_deck_id,json=targetDeckId,proto3" json:"target_deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeckRequest) Reset() {
+	*x = DeleteDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeckRequest) ProtoMessage() {}
+
+func (x *DeleteDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeckRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *DeleteDeckRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DeleteDeckRequest) GetTargetDeckId() string {
+	if x != nil {
+		return x.TargetDeckId
+	}
+	return ""
+}
+
+type ReadCardsFromDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadCardsFromDeckRequest) Reset() {
+	*x = ReadCardsFromDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadCardsFromDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadCardsFromDeckRequest) ProtoMessage() {}
+
+func (x *ReadCardsFromDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadCardsFromDeckRequest.ProtoReflect.Descriptor instead.
+func (*ReadCardsFromDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadCardsFromDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *ReadCardsFromDeckRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReadCardsFromDeckRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchPublicDecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPublicDecksRequest) Reset() {
+	*x = SearchPublicDecksRequest{}
+	mi := &file_deck_deck_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPublicDecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPublicDecksRequest) ProtoMessage() {}
+
+func (x *SearchPublicDecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPublicDecksRequest.ProtoReflect.Descriptor instead.
+func (*SearchPublicDecksRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchPublicDecksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPublicDecksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchPublicDecksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchAllPublicDecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decks         []*Deck                `protobuf:"bytes,1,rep,name=decks,proto3" json:"decks,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAllPublicDecksResponse) Reset() {
+	*x = SearchAllPublicDecksResponse{}
+	mi := &file_deck_deck_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAllPublicDecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAllPublicDecksResponse) ProtoMessage() {}
+
+func (x *SearchAllPublicDecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAllPublicDecksResponse.ProtoReflect.Descriptor instead.
+func (*SearchAllPublicDecksResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchAllPublicDecksResponse) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+func (x *SearchAllPublicDecksResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SearchUserPublicDecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUserPublicDecksRequest) Reset() {
+	*x = SearchUserPublicDecksRequest{}
+	mi := &file_deck_deck_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUserPublicDecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserPublicDecksRequest) ProtoMessage() {}
+
+func (x *SearchUserPublicDecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserPublicDecksRequest.ProtoReflect.Descriptor instead.
+func (*SearchUserPublicDecksRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchUserPublicDecksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchUserPublicDecksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchUserPublicDecksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUserPublicDecksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchUserPublicDecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decks         []*Deck                `protobuf:"bytes,1,rep,name=decks,proto3" json:"decks,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUserPublicDecksResponse) Reset() {
+	*x = SearchUserPublicDecksResponse{}
+	mi := &file_deck_deck_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUserPublicDecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserPublicDecksResponse) ProtoMessage() {}
+
+func (x *SearchUserPublicDecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserPublicDecksResponse.ProtoReflect.Descriptor instead.
+func (*SearchUserPublicDecksResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchUserPublicDecksResponse) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+func (x *SearchUserPublicDecksResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AddCardToDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCardToDeckRequest) Reset() {
+	*x = AddCardToDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCardToDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardToDeckRequest) ProtoMessage() {}
+
+func (x *AddCardToDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardToDeckRequest.ProtoReflect.Descriptor instead.
+func (*AddCardToDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddCardToDeckRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *AddCardToDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type RemoveCardFromDeckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCardFromDeckRequest) Reset() {
+	*x = RemoveCardFromDeckRequest{}
+	mi := &file_deck_deck_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCardFromDeckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardFromDeckRequest) ProtoMessage() {}
+
+func (x *RemoveCardFromDeckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardFromDeckRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardFromDeckRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveCardFromDeckRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *RemoveCardFromDeckRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type MoveCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardIds       []string               `protobuf:"bytes,1,rep,name=card_ids,json=cardIds,proto3" json:"card_ids,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // target deck
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardsRequest) Reset() {
+	*x = MoveCardsRequest{}
+	mi := &file_deck_deck_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardsRequest) ProtoMessage() {}
+
+func (x *MoveCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardsRequest.ProtoReflect.Descriptor instead.
+func (*MoveCardsRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MoveCardsRequest) GetCardIds() []string {
+	if x != nil {
+		return x.CardIds
+	}
+	return nil
+}
+
+func (x *MoveCardsRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+type MoveCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moved         int32                  `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"` // cards already in the target deck are not counted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardsResponse) Reset() {
+	*x = MoveCardsResponse{}
+	mi := &file_deck_deck_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardsResponse) ProtoMessage() {}
+
+func (x *MoveCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardsResponse.ProtoReflect.Descriptor instead.
+func (*MoveCardsResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveCardsResponse) GetMoved() int32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+type DeckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deck          *Deck                  `protobuf:"bytes,1,opt,name=deck,proto3" json:"deck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckResponse) Reset() {
+	*x = DeckResponse{}
+	mi := &file_deck_deck_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckResponse) ProtoMessage() {}
+
+func (x *DeckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckResponse.ProtoReflect.Descriptor instead.
+func (*DeckResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeckResponse) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+type DeckListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decks         []*Deck                `protobuf:"bytes,1,rep,name=decks,proto3" json:"decks,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckListResponse) Reset() {
+	*x = DeckListResponse{}
+	mi := &file_deck_deck_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckListResponse) ProtoMessage() {}
+
+func (x *DeckListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckListResponse.ProtoReflect.Descriptor instead.
+func (*DeckListResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeckListResponse) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+func (x *DeckListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CardListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*card.Card           `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardListResponse) Reset() {
+	*x = CardListResponse{}
+	mi := &file_deck_deck_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardListResponse) ProtoMessage() {}
+
+func (x *CardListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardListResponse.ProtoReflect.Descriptor instead.
+func (*CardListResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CardListResponse) GetCards() []*card.Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *CardListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Deck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Cards         []*card.Card           `protobuf:"bytes,6,rep,name=cards,proto3" json:"cards,omitempty"`
+	CardsQuantity uint32                 `protobuf:"varint,7,opt,name=cards_quantity,json=cardsQuantity,proto3" json:"cards_quantity,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,8,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// Set while the deck is in trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Full-text search results only: relevance and matches wrapped in <b></b>
+	Rank                 float32 `protobuf:"fixed32,10,opt,name=rank,proto3" json:"rank,omitempty"`
+	NameHighlight        string  `protobuf:"bytes,11,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	DescriptionHighlight string  `protobuf:"bytes,12,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	// Deck this one was cloned from, empty for decks made by the user
+	SourceDeckId string `protobuf:"bytes,13,opt,name=source_deck_id,json=sourceDeckId,proto3" json:"source_deck_id,omitempty"`
+	// Last published version, 0 until the deck is published
+	Version int32 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// Copies only: the copy follows updates of the source deck, merged up to synced_version
+	Subscribed    bool  `protobuf:"varint,15,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	SyncedVersion int32 `protobuf:"varint,16,opt,name=synced_version,json=syncedVersion,proto3" json:"synced_version,omitempty"`
+	// Role of the requesting user: owner for the author, otherwise the member role
+	Role string `protobuf:"bytes,17,opt,name=role,proto3" json:"role,omitempty"`
+	// Deck this one is nested in, empty for top-level decks
+	ParentDeckId  string `protobuf:"bytes,18,opt,name=parent_deck_id,json=parentDeckId,proto3" json:"parent_deck_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deck) Reset() {
+	*x = Deck{}
+	mi := &file_deck_deck_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deck) ProtoMessage() {}
+
+func (x *Deck) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deck.ProtoReflect.Descriptor instead.
+func (*Deck) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Deck) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *Deck) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Deck) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Deck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Deck) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Deck) GetCards() []*card.Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *Deck) GetCardsQuantity() uint32 {
+	if x != nil {
+		return x.CardsQuantity
+	}
+	return 0
+}
+
+func (x *Deck) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Deck) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Deck) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Deck) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *Deck) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+func (x *Deck) GetSourceDeckId() string {
+	if x != nil {
+		return x.SourceDeckId
+	}
+	return ""
+}
+
+func (x *Deck) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Deck) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+func (x *Deck) GetSyncedVersion() int32 {
+	if x != nil {
+		return x.SyncedVersion
+	}
+	return 0
+}
+
+func (x *Deck) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Deck) GetParentDeckId() string {
+	if x != nil {
+		return x.ParentDeckId
+	}
+	return ""
+}
+
+type DeckMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // viewer, editor or owner
+	InvitedBy     string                 `protobuf:"bytes,4,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckMember) Reset() {
+	*x = DeckMember{}
+	mi := &file_deck_deck_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckMember) ProtoMessage() {}
+
+func (x *DeckMember) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckMember.ProtoReflect.Descriptor instead.
+func (*DeckMember) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeckMember) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *DeckMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeckMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *DeckMember) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *DeckMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InviteDeckMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteDeckMemberRequest) Reset() {
+	*x = InviteDeckMemberRequest{}
+	mi := &file_deck_deck_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteDeckMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteDeckMemberRequest) ProtoMessage() {}
+
+func (x *InviteDeckMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteDeckMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteDeckMemberRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InviteDeckMemberRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *InviteDeckMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteDeckMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RemoveDeckMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeckId        string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeckMemberRequest) Reset() {
+	*x = RemoveDeckMemberRequest{}
+	mi := &file_deck_deck_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeckMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeckMemberRequest) ProtoMessage() {}
+
+func (x *RemoveDeckMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeckMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDeckMemberRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveDeckMemberRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *RemoveDeckMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeckMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *DeckMember            `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckMemberResponse) Reset() {
+	*x = DeckMemberResponse{}
+	mi := &file_deck_deck_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckMemberResponse) ProtoMessage() {}
+
+func (x *DeckMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckMemberResponse.ProtoReflect.Descriptor instead.
+func (*DeckMemberResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeckMemberResponse) GetMember() *DeckMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type DeckMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*DeckMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckMembersResponse) Reset() {
+	*x = DeckMembersResponse{}
+	mi := &file_deck_deck_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckMembersResponse) ProtoMessage() {}
+
+func (x *DeckMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckMembersResponse.ProtoReflect.Descriptor instead.
+func (*DeckMembersResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeckMembersResponse) GetMembers() []*DeckMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type DeckOptions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DeckId             string                 `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	LearningSteps      []int32                `protobuf:"varint,2,rep,packed,name=learning_steps,json=learningSteps,proto3" json:"learning_steps,omitempty"`         // in minutes
+	RelearningSteps    []int32                `protobuf:"varint,3,rep,packed,name=relearning_steps,json=relearningSteps,proto3" json:"relearning_steps,omitempty"`   // in minutes
+	GraduatingInterval int32                  `protobuf:"varint,4,opt,name=graduating_interval,json=graduatingInterval,proto3" json:"graduating_interval,omitempty"` // in days
+	EasyInterval       int32                  `protobuf:"varint,5,opt,name=easy_interval,json=easyInterval,proto3" json:"easy_interval,omitempty"`                   // in days
+	MaximumInterval    int32                  `protobuf:"varint,6,opt,name=maximum_interval,json=maximumInterval,proto3" json:"maximum_interval,omitempty"`          // in days
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NewPerDay          int32                  `protobuf:"varint,8,opt,name=new_per_day,json=newPerDay,proto3" json:"new_per_day,omitempty"`
+	MaxReviewsPerDay   int32                  `protobuf:"varint,9,opt,name=max_reviews_per_day,json=maxReviewsPerDay,proto3" json:"max_reviews_per_day,omitempty"`
+	Algorithm          string                 `protobuf:"bytes,10,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // empty means user preference
+	BurySiblings       bool                   `protobuf:"varint,11,opt,name=bury_siblings,json=burySiblings,proto3" json:"bury_siblings,omitempty"`
+	NewCardOrder       string                 `protobuf:"bytes,12,opt,name=new_card_order,json=newCardOrder,proto3" json:"new_card_order,omitempty"` // added or random
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeckOptions) Reset() {
+	*x = DeckOptions{}
+	mi := &file_deck_deck_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckOptions) ProtoMessage() {}
+
+func (x *DeckOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckOptions.ProtoReflect.Descriptor instead.
+func (*DeckOptions) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeckOptions) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *DeckOptions) GetLearningSteps() []int32 {
+	if x != nil {
+		return x.LearningSteps
+	}
+	return nil
+}
+
+func (x *DeckOptions) GetRelearningSteps() []int32 {
+	if x != nil {
+		return x.RelearningSteps
+	}
+	return nil
+}
+
+func (x *DeckOptions) GetGraduatingInterval() int32 {
+	if x != nil {
+		return x.GraduatingInterval
+	}
+	return 0
+}
+
+func (x *DeckOptions) GetEasyInterval() int32 {
+	if x != nil {
+		return x.EasyInterval
+	}
+	return 0
+}
+
+func (x *DeckOptions) GetMaximumInterval() int32 {
+	if x != nil {
+		return x.MaximumInterval
+	}
+	return 0
+}
+
+func (x *DeckOptions) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DeckOptions) GetNewPerDay() int32 {
+	if x != nil {
+		return x.NewPerDay
+	}
+	return 0
+}
+
+func (x *DeckOptions) GetMaxReviewsPerDay() int32 {
+	if x != nil {
+		return x.MaxReviewsPerDay
+	}
+	return 0
+}
+
+func (x *DeckOptions) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DeckOptions) GetBurySiblings() bool {
+	if x != nil {
+		return x.BurySiblings
+	}
+	return false
+}
+
+func (x *DeckOptions) GetNewCardOrder() string {
+	if x != nil {
+		return x.NewCardOrder
+	}
+	return ""
+}
+
+type UpdateDeckOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *DeckOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeckOptionsRequest) Reset() {
+	*x = UpdateDeckOptionsRequest{}
+	mi := &file_deck_deck_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeckOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeckOptionsRequest) ProtoMessage() {}
+
+func (x *UpdateDeckOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeckOptionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeckOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateDeckOptionsRequest) GetOptions() *DeckOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DeckOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *DeckOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeckOptionsResponse) Reset() {
+	*x = DeckOptionsResponse{}
+	mi := &file_deck_deck_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeckOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckOptionsResponse) ProtoMessage() {}
+
+func (x *DeckOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deck_deck_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckOptionsResponse.ProtoReflect.Descriptor instead.
+func (*DeckOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_deck_deck_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeckOptionsResponse) GetOptions() *DeckOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+var File_deck_deck_proto protoreflect.FileDescriptor
+
+const file_deck_deck_proto_rawDesc = "" +
+	"\n" +
+	"\x0fdeck/deck.proto\x12\x04deck\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x0fcard/card.proto\"\x89\x01\n" +
+	"\x0eAddDeckRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tis_public\x18\x03 \x01(\bR\bisPublic\x12$\n" +
+	"\x0eparent_deck_id\x18\x04 \x01(\tR\fparentDeckId\"*\n" +
+	"\x0fReadDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\"\xb5\x01\n" +
+	"\x11UpdateDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12 \n" +
+	"\tis_public\x18\x04 \x01(\bH\x02R\bisPublic\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_is_public\"P\n" +
+	"\x0fMoveDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12$\n" +
+	"\x0eparent_deck_id\x18\x02 \x01(\tR\fparentDeckId\"\x8e\x02\n" +
+	"\fDeckDueCount\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12$\n" +
+	"\x0eparent_deck_id\x18\x02 \x01(\tR\fparentDeckId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03new\x18\x04 \x01(\x05R\x03new\x12\x1a\n" +
+	"\blearning\x18\x05 \x01(\x05R\blearning\x12\x16\n" +
+	"\x06review\x18\x06 \x01(\x05R\x06review\x12\x1b\n" +
+	"\ttotal_new\x18\a \x01(\x05R\btotalNew\x12%\n" +
+	"\x0etotal_learning\x18\b \x01(\x05R\rtotalLearning\x12!\n" +
+	"\ftotal_review\x18\t \x01(\x05R\vtotalReview\"C\n" +
+	"\x15DeckDueCountsResponse\x12*\n" +
+	"\x06counts\x18\x01 \x03(\v2\x12.deck.DeckDueCountR\x06counts\"f\n" +
+	"\x11DeleteDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12$\n" +
+	"\x0etarget_deck_id\x18\x03 \x01(\tR\ftargetDeckId\"a\n" +
+	"\x18ReadCardsFromDeckRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"^\n" +
+	"\x18SearchPublicDecksRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"a\n" +
+	"\x1cSearchAllPublicDecksResponse\x12 \n" +
+	"\x05decks\x18\x01 \x03(\v2\n" +
+	".deck.DeckR\x05decks\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"{\n" +
+	"\x1cSearchUserPublicDecksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\"b\n" +
+	"\x1dSearchUserPublicDecksResponse\x12 \n" +
+	"\x05decks\x18\x01 \x03(\v2\n" +
+	".deck.DeckR\x05decks\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"H\n" +
+	"\x14AddCardToDeckRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\"M\n" +
+	"\x19RemoveCardFromDeckRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\"F\n" +
+	"\x10MoveCardsRequest\x12\x19\n" +
+	"\bcard_ids\x18\x01 \x03(\tR\acardIds\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\")\n" +
+	"\x11MoveCardsResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x05R\x05moved\".\n" +
+	"\fDeckResponse\x12\x1e\n" +
+	"\x04deck\x18\x01 \x01(\v2\n" +
+	".deck.DeckR\x04deck\"U\n" +
+	"\x10DeckListResponse\x12 \n" +
+	"\x05decks\x18\x01 \x03(\v2\n" +
+	".deck.DeckR\x05decks\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"U\n" +
+	"\x10CardListResponse\x12 \n" +
+	"\x05cards\x18\x01 \x03(\v2\n" +
+	".card.CardR\x05cards\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x81\x05\n" +
+	"\x04Deck\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12 \n" +
+	"\x05cards\x18\x06 \x03(\v2\n" +
+	".card.CardR\x05cards\x12%\n" +
+	"\x0ecards_quantity\x18\a \x01(\rR\rcardsQuantity\x12\x1b\n" +
+	"\tis_public\x18\b \x01(\bR\bisPublic\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04rank\x18\n" +
+	" \x01(\x02R\x04rank\x12%\n" +
+	"\x0ename_highlight\x18\v \x01(\tR\rnameHighlight\x123\n" +
+	"\x15description_highlight\x18\f \x01(\tR\x14descriptionHighlight\x12$\n" +
+	"\x0esource_deck_id\x18\r \x01(\tR\fsourceDeckId\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x05R\aversion\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x0f \x01(\bR\n" +
+	"subscribed\x12%\n" +
+	"\x0esynced_version\x18\x10 \x01(\x05R\rsyncedVersion\x12\x12\n" +
+	"\x04role\x18\x11 \x01(\tR\x04role\x12$\n" +
+	"\x0eparent_deck_id\x18\x12 \x01(\tR\fparentDeckId\"\xac\x01\n" +
+	"\n" +
+	"DeckMember\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x04 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"_\n" +
+	"\x17InviteDeckMemberRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"K\n" +
+	"\x17RemoveDeckMemberRequest\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x12DeckMemberResponse\x12(\n" +
+	"\x06member\x18\x01 \x01(\v2\x10.deck.DeckMemberR\x06member\"A\n" +
+	"\x13DeckMembersResponse\x12*\n" +
+	"\amembers\x18\x01 \x03(\v2\x10.deck.DeckMemberR\amembers\"\xec\x03\n" +
+	"\vDeckOptions\x12\x17\n" +
+	"\adeck_id\x18\x01 \x01(\tR\x06deckId\x12%\n" +
+	"\x0elearning_steps\x18\x02 \x03(\x05R\rlearningSteps\x12)\n" +
+	"\x10relearning_steps\x18\x03 \x03(\x05R\x0frelearningSteps\x12/\n" +
+	"\x13graduating_interval\x18\x04 \x01(\x05R\x12graduatingInterval\x12#\n" +
+	"\reasy_interval\x18\x05 \x01(\x05R\feasyInterval\x12)\n" +
+	"\x10maximum_interval\x18\x06 \x01(\x05R\x0fmaximumInterval\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1e\n" +
+	"\vnew_per_day\x18\b \x01(\x05R\tnewPerDay\x12-\n" +
+	"\x13max_reviews_per_day\x18\t \x01(\x05R\x10maxReviewsPerDay\x12\x1c\n" +
+	"\talgorithm\x18\n" +
+	" \x01(\tR\talgorithm\x12#\n" +
+	"\rbury_siblings\x18\v \x01(\bR\fburySiblings\x12$\n" +
+	"\x0enew_card_order\x18\f \x01(\tR\fnewCardOrder\"G\n" +
+	"\x18UpdateDeckOptionsRequest\x12+\n" +
+	"\aoptions\x18\x01 \x01(\v2\x11.deck.DeckOptionsR\aoptions\"B\n" +
+	"\x13DeckOptionsResponse\x12+\n" +
+	"\aoptions\x18\x01 \x01(\v2\x11.deck.DeckOptionsR\aoptions2\xec\f\n" +
+	"\vDeckService\x123\n" +
+	"\aAddDeck\x12\x14.deck.AddDeckRequest\x1a\x12.deck.DeckResponse\x129\n" +
+	"\fReadAllDecks\x12\x11.card.PageRequest\x1a\x16.deck.DeckListResponse\x125\n" +
+	"\bReadDeck\x12\x15.deck.ReadDeckRequest\x1a\x12.deck.DeckResponse\x129\n" +
+	"\n" +
+	"UpdateDeck\x12\x17.deck.UpdateDeckRequest\x1a\x12.deck.DeckResponse\x12=\n" +
+	"\fReadDeckTree\x12\x15.deck.ReadDeckRequest\x1a\x16.deck.DeckListResponse\x125\n" +
+	"\bMoveDeck\x12\x15.deck.MoveDeckRequest\x1a\x12.deck.DeckResponse\x12G\n" +
+	"\x11ReadDeckDueCounts\x12\x15.deck.ReadDeckRequest\x1a\x1b.deck.DeckDueCountsResponse\x12Z\n" +
+	"\x14SearchAllPublicDecks\x12\x1e.deck.SearchPublicDecksRequest\x1a\".deck.SearchAllPublicDecksResponse\x12`\n" +
+	"\x15SearchUserPublicDecks\x12\".deck.SearchUserPublicDecksRequest\x1a#.deck.SearchUserPublicDecksResponse\x12=\n" +
+	"\n" +
+	"DeleteDeck\x12\x17.deck.DeleteDeckRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x10ReadTrashedDecks\x12\x16.google.protobuf.Empty\x1a\x16.deck.DeckListResponse\x128\n" +
+	"\vRestoreDeck\x12\x15.deck.ReadDeckRequest\x1a\x12.deck.DeckResponse\x126\n" +
+	"\tCloneDeck\x12\x15.deck.ReadDeckRequest\x1a\x12.deck.DeckResponse\x12:\n" +
+	"\rSubscribeDeck\x12\x15.deck.ReadDeckRequest\x1a\x12.deck.DeckResponse\x12<\n" +
+	"\x0fUnsubscribeDeck\x12\x15.deck.ReadDeckRequest\x1a\x12.deck.DeckResponse\x12C\n" +
+	"\x0fReadDeckMembers\x12\x15.deck.ReadDeckRequest\x1a\x19.deck.DeckMembersResponse\x12K\n" +
+	"\x10InviteDeckMember\x12\x1d.deck.InviteDeckMemberRequest\x1a\x18.deck.DeckMemberResponse\x12I\n" +
+	"\x10RemoveDeckMember\x12\x1d.deck.RemoveDeckMemberRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\rAddCardToDeck\x12\x1a.deck.AddCardToDeckRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x12RemoveCardFromDeck\x12\x1f.deck.RemoveCardFromDeckRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\tMoveCards\x12\x16.deck.MoveCardsRequest\x1a\x17.deck.MoveCardsResponse\x12K\n" +
+	"\x11ReadCardsFromDeck\x12\x1e.deck.ReadCardsFromDeckRequest\x1a\x16.deck.CardListResponse\x12C\n" +
+	"\x0fReadDeckOptions\x12\x15.deck.ReadDeckRequest\x1a\x19.deck.DeckOptionsResponse\x12N\n" +
+	"\x11UpdateDeckOptions\x12\x1e.deck.UpdateDeckOptionsRequest\x1a\x19.deck.DeckOptionsResponseB7Z5github.com/GOeda-Co/proto-contract/gen/go/deck;deckv1b\x06proto3"
+
+var (
+	file_deck_deck_proto_rawDescOnce sync.Once
+	file_deck_deck_proto_rawDescData []byte
+)
+
+func file_deck_deck_proto_rawDescGZIP() []byte {
+	file_deck_deck_proto_rawDescOnce.Do(func() {
+		file_deck_deck_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_deck_deck_proto_rawDesc), len(file_deck_deck_proto_rawDesc)))
+	})
+	return file_deck_deck_proto_rawDescData
+}
+
+var file_deck_deck_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_deck_deck_proto_goTypes = []any{
+	(*AddDeckRequest)(nil),                // 0: deck.AddDeckRequest
+	(*ReadDeckRequest)(nil),               // 1: deck.ReadDeckRequest
+	(*UpdateDeckRequest)(nil),             // 2: deck.UpdateDeckRequest
+	(*MoveDeckRequest)(nil),               // 3: deck.MoveDeckRequest
+	(*DeckDueCount)(nil),                  // 4: deck.DeckDueCount
+	(*DeckDueCountsResponse)(nil),         // 5: deck.DeckDueCountsResponse
+	(*DeleteDeckRequest)(nil),             // 6: deck.DeleteDeckRequest
+	(*ReadCardsFromDeckRequest)(nil),      // 7: deck.ReadCardsFromDeckRequest
+	(*SearchPublicDecksRequest)(nil),      // 8: deck.SearchPublicDecksRequest
+	(*SearchAllPublicDecksResponse)(nil),  // 9: deck.SearchAllPublicDecksResponse
+	(*SearchUserPublicDecksRequest)(nil),  // 10: deck.SearchUserPublicDecksRequest
+	(*SearchUserPublicDecksResponse)(nil), // 11: deck.SearchUserPublicDecksResponse
+	(*AddCardToDeckRequest)(nil),          // 12: deck.AddCardToDeckRequest
+	(*RemoveCardFromDeckRequest)(nil),     // 13: deck.RemoveCardFromDeckRequest
+	(*MoveCardsRequest)(nil),              // 14: deck.MoveCardsRequest
+	(*MoveCardsResponse)(nil),             // 15: deck.MoveCardsResponse
+	(*DeckResponse)(nil),                  // 16: deck.DeckResponse
+	(*DeckListResponse)(nil),              // 17: deck.DeckListResponse
+	(*CardListResponse)(nil),              // 18: deck.CardListResponse
+	(*Deck)(nil),                          // 19: deck.Deck
+	(*DeckMember)(nil),                    // 20: deck.DeckMember
+	(*InviteDeckMemberRequest)(nil),       // 21: deck.InviteDeckMemberRequest
+	(*RemoveDeckMemberRequest)(nil),       // 22: deck.RemoveDeckMemberRequest
+	(*DeckMemberResponse)(nil),            // 23: deck.DeckMemberResponse
+	(*DeckMembersResponse)(nil),           // 24: deck.DeckMembersResponse
+	(*DeckOptions)(nil),                   // 25: deck.DeckOptions
+	(*UpdateDeckOptionsRequest)(nil),      // 26: deck.UpdateDeckOptionsRequest
+	(*DeckOptionsResponse)(nil),           // 27: deck.DeckOptionsResponse
+	(*card.Card)(nil),                     // 28: card.Card
+	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(*card.PageRequest)(nil),              // 30: card.PageRequest
+	(*emptypb.Empty)(nil),                 // 31: google.protobuf.Empty
+}
+var file_deck_deck_proto_depIdxs = []int32{
+	4,  // 0: deck.DeckDueCountsResponse.counts:type_name -> deck.DeckDueCount
+	19, // 1: deck.SearchAllPublicDecksResponse.decks:type_name -> deck.Deck
+	19, // 2: deck.SearchUserPublicDecksResponse.decks:type_name -> deck.Deck
+	19, // 3: deck.DeckResponse.deck:type_name -> deck.Deck
+	19, // 4: deck.DeckListResponse.decks:type_name -> deck.Deck
+	28, // 5: deck.CardListResponse.cards:type_name -> card.Card
+	29, // 6: deck.Deck.created_at:type_name -> google.protobuf.Timestamp
+	28, // 7: deck.Deck.cards:type_name -> card.Card
+	29, // 8: deck.Deck.deleted_at:type_name -> google.protobuf.Timestamp
+	29, // 9: deck.DeckMember.created_at:type_name -> google.protobuf.Timestamp
+	20, // 10: deck.DeckMemberResponse.member:type_name -> deck.DeckMember
+	20, // 11: deck.DeckMembersResponse.members:type_name -> deck.DeckMember
+	29, // 12: deck.DeckOptions.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 13: deck.UpdateDeckOptionsRequest.options:type_name -> deck.DeckOptions
+	25, // 14: deck.DeckOptionsResponse.options:type_name -> deck.DeckOptions
+	0,  // 15: deck.DeckService.AddDeck:input_type -> deck.AddDeckRequest
+	30, // 16: deck.DeckService.ReadAllDecks:input_type -> card.PageRequest
+	1,  // 17: deck.DeckService.ReadDeck:input_type -> deck.ReadDeckRequest
+	2,  // 18: deck.DeckService.UpdateDeck:input_type -> deck.UpdateDeckRequest
+	1,  // 19: deck.DeckService.ReadDeckTree:input_type -> deck.ReadDeckRequest
+	3,  // 20: deck.DeckService.MoveDeck:input_type -> deck.MoveDeckRequest
+	1,  // 21: deck.DeckService.ReadDeckDueCounts:input_type -> deck.ReadDeckRequest
+	8,  // 22: deck.DeckService.SearchAllPublicDecks:input_type -> deck.SearchPublicDecksRequest
+	10, // 23: deck.DeckService.SearchUserPublicDecks:input_type -> deck.SearchUserPublicDecksRequest
+	6,  // 24: deck.DeckService.DeleteDeck:input_type -> deck.DeleteDeckRequest
+	31, // 25: deck.DeckService.ReadTrashedDecks:input_type -> google.protobuf.Empty
+	1,  // 26: deck.DeckService.RestoreDeck:input_type -> deck.ReadDeckRequest
+	1,  // 27: deck.DeckService.CloneDeck:input_type -> deck.ReadDeckRequest
+	1,  // 28: deck.DeckService.SubscribeDeck:input_type -> deck.ReadDeckRequest
+	1,  // 29: deck.DeckService.UnsubscribeDeck:input_type -> deck.ReadDeckRequest
+	1,  // 30: deck.DeckService.ReadDeckMembers:input_type -> deck.ReadDeckRequest
+	21, // 31: deck.DeckService.InviteDeckMember:input_type -> deck.InviteDeckMemberRequest
+	22, // 32: deck.DeckService.RemoveDeckMember:input_type -> deck.RemoveDeckMemberRequest
+	12, // 33: deck.DeckService.AddCardToDeck:input_type -> deck.AddCardToDeckRequest
+	13, // 34: deck.DeckService.RemoveCardFromDeck:input_type -> deck.RemoveCardFromDeckRequest
+	14, // 35: deck.DeckService.MoveCards:input_type -> deck.MoveCardsRequest
+	7,  // 36: deck.DeckService.ReadCardsFromDeck:input_type -> deck.ReadCardsFromDeckRequest
+	1,  // 37: deck.DeckService.ReadDeckOptions:input_type -> deck.ReadDeckRequest
+	26, // 38: deck.DeckService.UpdateDeckOptions:input_type -> deck.UpdateDeckOptionsRequest
+	16, // 39: deck.DeckService.AddDeck:output_type -> deck.DeckResponse
+	17, // 40: deck.DeckService.ReadAllDecks:output_type -> deck.DeckListResponse
+	16, // 41: deck.DeckService.ReadDeck:output_type -> deck.DeckResponse
+	16, // 42: deck.DeckService.UpdateDeck:output_type -> deck.DeckResponse
+	17, // 43: deck.DeckService.ReadDeckTree:output_type -> deck.DeckListResponse
+	16, // 44: deck.DeckService.MoveDeck:output_type -> deck.DeckResponse
+	5,  // 45: deck.DeckService.ReadDeckDueCounts:output_type -> deck.DeckDueCountsResponse
+	9,  // 46: deck.DeckService.SearchAllPublicDecks:output_type -> deck.SearchAllPublicDecksResponse
+	11, // 47: deck.DeckService.SearchUserPublicDecks:output_type -> deck.SearchUserPublicDecksResponse
+	31, // 48: deck.DeckService.DeleteDeck:output_type -> google.protobuf.Empty
+	17, // 49: deck.DeckService.ReadTrashedDecks:output_type -> deck.DeckListResponse
+	16, // 50: deck.DeckService.RestoreDeck:output_type -> deck.DeckResponse
+	16, // 51: deck.DeckService.CloneDeck:output_type -> deck.DeckResponse
+	16, // 52: deck.DeckService.SubscribeDeck:output_type -> deck.DeckResponse
+	16, // 53: deck.DeckService.UnsubscribeDeck:output_type -> deck.DeckResponse
+	24, // 54: deck.DeckService.ReadDeckMembers:output_type -> deck.DeckMembersResponse
+	23, // 55: deck.DeckService.InviteDeckMember:output_type -> deck.DeckMemberResponse
+	31, // 56: deck.DeckService.RemoveDeckMember:output_type -> google.protobuf.Empty
+	31, // 57: deck.DeckService.AddCardToDeck:output_type -> google.protobuf.Empty
+	31, // 58: deck.DeckService.RemoveCardFromDeck:output_type -> google.protobuf.Empty
+	15, // 59: deck.DeckService.MoveCards:output_type -> deck.MoveCardsResponse
+	18, // 60: deck.DeckService.ReadCardsFromDeck:output_type -> deck.CardListResponse
+	27, // 61: deck.DeckService.ReadDeckOptions:output_type -> deck.DeckOptionsResponse
+	27, // 62: deck.DeckService.UpdateDeckOptions:output_type -> deck.DeckOptionsResponse
+	39, // [39:63] is the sub-list for method output_type
+	15, // [15:39] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_deck_deck_proto_init() }
+func file_deck_deck_proto_init() {
+	if File_deck_deck_proto != nil {
+		return
+	}
+	file_deck_deck_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deck_deck_proto_rawDesc), len(file_deck_deck_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_deck_deck_proto_goTypes,
+		DependencyIndexes: file_deck_deck_proto_depIdxs,
+		MessageInfos:      file_deck_deck_proto_msgTypes,
+	}.Build()
+	File_deck_deck_proto = out.File
+	file_deck_deck_proto_goTypes = nil
+	file_deck_deck_proto_depIdxs = nil
+}
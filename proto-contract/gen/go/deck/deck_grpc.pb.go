@@ -0,0 +1,1039 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: deck/deck.proto
+
+package deckv1
+
+import (
+	context "context"
+	card "github.com/GOeda-Co/proto-contract/gen/go/card"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DeckService_AddDeck_FullMethodName               = "/deck.DeckService/AddDeck"
+	DeckService_ReadAllDecks_FullMethodName          = "/deck.DeckService/ReadAllDecks"
+	DeckService_ReadDeck_FullMethodName              = "/deck.DeckService/ReadDeck"
+	DeckService_UpdateDeck_FullMethodName            = "/deck.DeckService/UpdateDeck"
+	DeckService_ReadDeckTree_FullMethodName          = "/deck.DeckService/ReadDeckTree"
+	DeckService_MoveDeck_FullMethodName              = "/deck.DeckService/MoveDeck"
+	DeckService_ReadDeckDueCounts_FullMethodName     = "/deck.DeckService/ReadDeckDueCounts"
+	DeckService_SearchAllPublicDecks_FullMethodName  = "/deck.DeckService/SearchAllPublicDecks"
+	DeckService_SearchUserPublicDecks_FullMethodName = "/deck.DeckService/SearchUserPublicDecks"
+	DeckService_DeleteDeck_FullMethodName            = "/deck.DeckService/DeleteDeck"
+	DeckService_ReadTrashedDecks_FullMethodName      = "/deck.DeckService/ReadTrashedDecks"
+	DeckService_RestoreDeck_FullMethodName           = "/deck.DeckService/RestoreDeck"
+	DeckService_CloneDeck_FullMethodName             = "/deck.DeckService/CloneDeck"
+	DeckService_SubscribeDeck_FullMethodName         = "/deck.DeckService/SubscribeDeck"
+	DeckService_UnsubscribeDeck_FullMethodName       = "/deck.DeckService/UnsubscribeDeck"
+	DeckService_ReadDeckMembers_FullMethodName       = "/deck.DeckService/ReadDeckMembers"
+	DeckService_InviteDeckMember_FullMethodName      = "/deck.DeckService/InviteDeckMember"
+	DeckService_RemoveDeckMember_FullMethodName      = "/deck.DeckService/RemoveDeckMember"
+	DeckService_AddCardToDeck_FullMethodName         = "/deck.DeckService/AddCardToDeck"
+	DeckService_RemoveCardFromDeck_FullMethodName    = "/deck.DeckService/RemoveCardFromDeck"
+	DeckService_MoveCards_FullMethodName             = "/deck.DeckService/MoveCards"
+	DeckService_ReadCardsFromDeck_FullMethodName     = "/deck.DeckService/ReadCardsFromDeck"
+	DeckService_ReadDeckOptions_FullMethodName       = "/deck.DeckService/ReadDeckOptions"
+	DeckService_UpdateDeckOptions_FullMethodName     = "/deck.DeckService/UpdateDeckOptions"
+)
+
+// DeckServiceClient is the client API for DeckService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeckServiceClient interface {
+	AddDeck(ctx context.Context, in *AddDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Decks of the user, newest first, one page at a time
+	ReadAllDecks(ctx context.Context, in *card.PageRequest, opts ...grpc.CallOption) (*DeckListResponse, error)
+	ReadDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Changes only fields that are set. Cards of the deck follow its visibility
+	UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// The deck with all of its subdecks outside trash, oldest first
+	ReadDeckTree(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckListResponse, error)
+	// Nests the deck with its subdecks under another deck of the same author, or makes it top-level
+	MoveDeck(ctx context.Context, in *MoveDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Cards of the user due today in the deck and each of its subdecks, alone and with their subdecks
+	ReadDeckDueCounts(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckDueCountsResponse, error)
+	// With a query only decks matching name or description are returned, best matches first
+	SearchAllPublicDecks(ctx context.Context, in *SearchPublicDecksRequest, opts ...grpc.CallOption) (*SearchAllPublicDecksResponse, error)
+	SearchUserPublicDecks(ctx context.Context, in *SearchUserPublicDecksRequest, opts ...grpc.CallOption) (*SearchUserPublicDecksResponse, error)
+	// Moves the deck to trash, it is purged after the retention period.
+	// Cards of the deck are trashed with it, moved to another deck or detached depending on mode
+	DeleteDeck(ctx context.Context, in *DeleteDeckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Decks of the user in trash, recently deleted first
+	ReadTrashedDecks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DeckListResponse, error)
+	// Restores the deck together with cards trashed along with it
+	RestoreDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Copies a public or own deck with its options and cards to the user. Cards start
+	// with fresh scheduling, the copy refers back to the source deck
+	CloneDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Clones a public deck of another user as a copy that follows its published updates
+	SubscribeDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Stops following updates, the copy stays with the user
+	UnsubscribeDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error)
+	// Users sharing the deck with its author and their roles
+	ReadDeckMembers(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckMembersResponse, error)
+	// Gives the user a role in the deck or changes it. Only owners manage members
+	InviteDeckMember(ctx context.Context, in *InviteDeckMemberRequest, opts ...grpc.CallOption) (*DeckMemberResponse, error)
+	// Takes the user's role away. Owners remove anyone, other members only leave
+	RemoveDeckMember(ctx context.Context, in *RemoveDeckMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Moves the card into the deck, taking it out of the deck it was in
+	AddCardToDeck(ctx context.Context, in *AddCardToDeckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Takes the card out of the deck, the card stays without a deck
+	RemoveCardFromDeck(ctx context.Context, in *RemoveCardFromDeckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Moves cards into the deck in one transaction, all of them or none
+	MoveCards(ctx context.Context, in *MoveCardsRequest, opts ...grpc.CallOption) (*MoveCardsResponse, error)
+	ReadCardsFromDeck(ctx context.Context, in *ReadCardsFromDeckRequest, opts ...grpc.CallOption) (*CardListResponse, error)
+	// Study settings of the deck (learning steps, intervals)
+	ReadDeckOptions(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckOptionsResponse, error)
+	UpdateDeckOptions(ctx context.Context, in *UpdateDeckOptionsRequest, opts ...grpc.CallOption) (*DeckOptionsResponse, error)
+}
+
+type deckServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeckServiceClient(cc grpc.ClientConnInterface) DeckServiceClient {
+	return &deckServiceClient{cc}
+}
+
+func (c *deckServiceClient) AddDeck(ctx context.Context, in *AddDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_AddDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadAllDecks(ctx context.Context, in *card.PageRequest, opts ...grpc.CallOption) (*DeckListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckListResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadAllDecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) UpdateDeck(ctx context.Context, in *UpdateDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_UpdateDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadDeckTree(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckListResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadDeckTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) MoveDeck(ctx context.Context, in *MoveDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_MoveDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadDeckDueCounts(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckDueCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckDueCountsResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadDeckDueCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) SearchAllPublicDecks(ctx context.Context, in *SearchPublicDecksRequest, opts ...grpc.CallOption) (*SearchAllPublicDecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAllPublicDecksResponse)
+	err := c.cc.Invoke(ctx, DeckService_SearchAllPublicDecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) SearchUserPublicDecks(ctx context.Context, in *SearchUserPublicDecksRequest, opts ...grpc.CallOption) (*SearchUserPublicDecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUserPublicDecksResponse)
+	err := c.cc.Invoke(ctx, DeckService_SearchUserPublicDecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) DeleteDeck(ctx context.Context, in *DeleteDeckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeckService_DeleteDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadTrashedDecks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DeckListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckListResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadTrashedDecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) RestoreDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_RestoreDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) CloneDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_CloneDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) SubscribeDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_SubscribeDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) UnsubscribeDeck(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckResponse)
+	err := c.cc.Invoke(ctx, DeckService_UnsubscribeDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadDeckMembers(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckMembersResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadDeckMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) InviteDeckMember(ctx context.Context, in *InviteDeckMemberRequest, opts ...grpc.CallOption) (*DeckMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckMemberResponse)
+	err := c.cc.Invoke(ctx, DeckService_InviteDeckMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) RemoveDeckMember(ctx context.Context, in *RemoveDeckMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeckService_RemoveDeckMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) AddCardToDeck(ctx context.Context, in *AddCardToDeckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeckService_AddCardToDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) RemoveCardFromDeck(ctx context.Context, in *RemoveCardFromDeckRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeckService_RemoveCardFromDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) MoveCards(ctx context.Context, in *MoveCardsRequest, opts ...grpc.CallOption) (*MoveCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCardsResponse)
+	err := c.cc.Invoke(ctx, DeckService_MoveCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadCardsFromDeck(ctx context.Context, in *ReadCardsFromDeckRequest, opts ...grpc.CallOption) (*CardListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CardListResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadCardsFromDeck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) ReadDeckOptions(ctx context.Context, in *ReadDeckRequest, opts ...grpc.CallOption) (*DeckOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckOptionsResponse)
+	err := c.cc.Invoke(ctx, DeckService_ReadDeckOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckServiceClient) UpdateDeckOptions(ctx context.Context, in *UpdateDeckOptionsRequest, opts ...grpc.CallOption) (*DeckOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeckOptionsResponse)
+	err := c.cc.Invoke(ctx, DeckService_UpdateDeckOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeckServiceServer is the server API for DeckService service.
+// All implementations must embed UnimplementedDeckServiceServer
+// for forward compatibility.
+type DeckServiceServer interface {
+	AddDeck(context.Context, *AddDeckRequest) (*DeckResponse, error)
+	// Decks of the user, newest first, one page at a time
+	ReadAllDecks(context.Context, *card.PageRequest) (*DeckListResponse, error)
+	ReadDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error)
+	// Changes only fields that are set. Cards of the deck follow its visibility
+	UpdateDeck(context.Context, *UpdateDeckRequest) (*DeckResponse, error)
+	// The deck with all of its subdecks outside trash, oldest first
+	ReadDeckTree(context.Context, *ReadDeckRequest) (*DeckListResponse, error)
+	// Nests the deck with its subdecks under another deck of the same author, or makes it top-level
+	MoveDeck(context.Context, *MoveDeckRequest) (*DeckResponse, error)
+	// Cards of the user due today in the deck and each of its subdecks, alone and with their subdecks
+	ReadDeckDueCounts(context.Context, *ReadDeckRequest) (*DeckDueCountsResponse, error)
+	// With a query only decks matching name or description are returned, best matches first
+	SearchAllPublicDecks(context.Context, *SearchPublicDecksRequest) (*SearchAllPublicDecksResponse, error)
+	SearchUserPublicDecks(context.Context, *SearchUserPublicDecksRequest) (*SearchUserPublicDecksResponse, error)
+	// Moves the deck to trash, it is purged after the retention period.
+	// Cards of the deck are trashed with it, moved to another deck or detached depending on mode
+	DeleteDeck(context.Context, *DeleteDeckRequest) (*emptypb.Empty, error)
+	// Decks of the user in trash, recently deleted first
+	ReadTrashedDecks(context.Context, *emptypb.Empty) (*DeckListResponse, error)
+	// Restores the deck together with cards trashed along with it
+	RestoreDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error)
+	// Copies a public or own deck with its options and cards to the user. Cards start
+	// with fresh scheduling, the copy refers back to the source deck
+	CloneDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error)
+	// Clones a public deck of another user as a copy that follows its published updates
+	SubscribeDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error)
+	// Stops following updates, the copy stays with the user
+	UnsubscribeDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error)
+	// Users sharing the deck with its author and their roles
+	ReadDeckMembers(context.Context, *ReadDeckRequest) (*DeckMembersResponse, error)
+	// Gives the user a role in the deck or changes it. Only owners manage members
+	InviteDeckMember(context.Context, *InviteDeckMemberRequest) (*DeckMemberResponse, error)
+	// Takes the user's role away. Owners remove anyone, other members only leave
+	RemoveDeckMember(context.Context, *RemoveDeckMemberRequest) (*emptypb.Empty, error)
+	// Moves the card into the deck, taking it out of the deck it was in
+	AddCardToDeck(context.Context, *AddCardToDeckRequest) (*emptypb.Empty, error)
+	// Takes the card out of the deck, the card stays without a deck
+	RemoveCardFromDeck(context.Context, *RemoveCardFromDeckRequest) (*emptypb.Empty, error)
+	// Moves cards into the deck in one transaction, all of them or none
+	MoveCards(context.Context, *MoveCardsRequest) (*MoveCardsResponse, error)
+	ReadCardsFromDeck(context.Context, *ReadCardsFromDeckRequest) (*CardListResponse, error)
+	// Study settings of the deck (learning steps, intervals)
+	ReadDeckOptions(context.Context, *ReadDeckRequest) (*DeckOptionsResponse, error)
+	UpdateDeckOptions(context.Context, *UpdateDeckOptionsRequest) (*DeckOptionsResponse, error)
+	mustEmbedUnimplementedDeckServiceServer()
+}
+
+// UnimplementedDeckServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeckServiceServer struct{}
+
+func (UnimplementedDeckServiceServer) AddDeck(context.Context, *AddDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadAllDecks(context.Context, *card.PageRequest) (*DeckListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllDecks not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) UpdateDeck(context.Context, *UpdateDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadDeckTree(context.Context, *ReadDeckRequest) (*DeckListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeckTree not implemented")
+}
+func (UnimplementedDeckServiceServer) MoveDeck(context.Context, *MoveDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadDeckDueCounts(context.Context, *ReadDeckRequest) (*DeckDueCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeckDueCounts not implemented")
+}
+func (UnimplementedDeckServiceServer) SearchAllPublicDecks(context.Context, *SearchPublicDecksRequest) (*SearchAllPublicDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAllPublicDecks not implemented")
+}
+func (UnimplementedDeckServiceServer) SearchUserPublicDecks(context.Context, *SearchUserPublicDecksRequest) (*SearchUserPublicDecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUserPublicDecks not implemented")
+}
+func (UnimplementedDeckServiceServer) DeleteDeck(context.Context, *DeleteDeckRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadTrashedDecks(context.Context, *emptypb.Empty) (*DeckListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTrashedDecks not implemented")
+}
+func (UnimplementedDeckServiceServer) RestoreDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) CloneDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) SubscribeDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) UnsubscribeDeck(context.Context, *ReadDeckRequest) (*DeckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadDeckMembers(context.Context, *ReadDeckRequest) (*DeckMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeckMembers not implemented")
+}
+func (UnimplementedDeckServiceServer) InviteDeckMember(context.Context, *InviteDeckMemberRequest) (*DeckMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteDeckMember not implemented")
+}
+func (UnimplementedDeckServiceServer) RemoveDeckMember(context.Context, *RemoveDeckMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeckMember not implemented")
+}
+func (UnimplementedDeckServiceServer) AddCardToDeck(context.Context, *AddCardToDeckRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCardToDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) RemoveCardFromDeck(context.Context, *RemoveCardFromDeckRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCardFromDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) MoveCards(context.Context, *MoveCardsRequest) (*MoveCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCards not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadCardsFromDeck(context.Context, *ReadCardsFromDeckRequest) (*CardListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadCardsFromDeck not implemented")
+}
+func (UnimplementedDeckServiceServer) ReadDeckOptions(context.Context, *ReadDeckRequest) (*DeckOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeckOptions not implemented")
+}
+func (UnimplementedDeckServiceServer) UpdateDeckOptions(context.Context, *UpdateDeckOptionsRequest) (*DeckOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeckOptions not implemented")
+}
+func (UnimplementedDeckServiceServer) mustEmbedUnimplementedDeckServiceServer() {}
+func (UnimplementedDeckServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDeckServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeckServiceServer will
+// result in compilation errors.
+type UnsafeDeckServiceServer interface {
+	mustEmbedUnimplementedDeckServiceServer()
+}
+
+func RegisterDeckServiceServer(s grpc.ServiceRegistrar, srv DeckServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDeckServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeckService_ServiceDesc, srv)
+}
+
+func _DeckService_AddDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).AddDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_AddDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).AddDeck(ctx, req.(*AddDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadAllDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(card.PageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadAllDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadAllDecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadAllDecks(ctx, req.(*card.PageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadDeck(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_UpdateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).UpdateDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_UpdateDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).UpdateDeck(ctx, req.(*UpdateDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadDeckTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadDeckTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadDeckTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadDeckTree(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_MoveDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).MoveDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_MoveDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).MoveDeck(ctx, req.(*MoveDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadDeckDueCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadDeckDueCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadDeckDueCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadDeckDueCounts(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_SearchAllPublicDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPublicDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).SearchAllPublicDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_SearchAllPublicDecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).SearchAllPublicDecks(ctx, req.(*SearchPublicDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_SearchUserPublicDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserPublicDecksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).SearchUserPublicDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_SearchUserPublicDecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).SearchUserPublicDecks(ctx, req.(*SearchUserPublicDecksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_DeleteDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).DeleteDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_DeleteDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).DeleteDeck(ctx, req.(*DeleteDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadTrashedDecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadTrashedDecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadTrashedDecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadTrashedDecks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_RestoreDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).RestoreDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_RestoreDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).RestoreDeck(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_CloneDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).CloneDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_CloneDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).CloneDeck(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_SubscribeDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).SubscribeDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_SubscribeDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).SubscribeDeck(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_UnsubscribeDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).UnsubscribeDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_UnsubscribeDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).UnsubscribeDeck(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadDeckMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadDeckMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadDeckMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadDeckMembers(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_InviteDeckMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteDeckMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).InviteDeckMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_InviteDeckMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).InviteDeckMember(ctx, req.(*InviteDeckMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_RemoveDeckMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeckMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).RemoveDeckMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_RemoveDeckMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).RemoveDeckMember(ctx, req.(*RemoveDeckMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_AddCardToDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCardToDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).AddCardToDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_AddCardToDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).AddCardToDeck(ctx, req.(*AddCardToDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_RemoveCardFromDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCardFromDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).RemoveCardFromDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_RemoveCardFromDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).RemoveCardFromDeck(ctx, req.(*RemoveCardFromDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_MoveCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).MoveCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_MoveCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).MoveCards(ctx, req.(*MoveCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadCardsFromDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadCardsFromDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadCardsFromDeck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadCardsFromDeck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadCardsFromDeck(ctx, req.(*ReadCardsFromDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_ReadDeckOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).ReadDeckOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_ReadDeckOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).ReadDeckOptions(ctx, req.(*ReadDeckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeckService_UpdateDeckOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeckOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServiceServer).UpdateDeckOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeckService_UpdateDeckOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServiceServer).UpdateDeckOptions(ctx, req.(*UpdateDeckOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeckService_ServiceDesc is the grpc.ServiceDesc for DeckService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeckService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "deck.DeckService",
+	HandlerType: (*DeckServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddDeck",
+			Handler:    _DeckService_AddDeck_Handler,
+		},
+		{
+			MethodName: "ReadAllDecks",
+			Handler:    _DeckService_ReadAllDecks_Handler,
+		},
+		{
+			MethodName: "ReadDeck",
+			Handler:    _DeckService_ReadDeck_Handler,
+		},
+		{
+			MethodName: "UpdateDeck",
+			Handler:    _DeckService_UpdateDeck_Handler,
+		},
+		{
+			MethodName: "ReadDeckTree",
+			Handler:    _DeckService_ReadDeckTree_Handler,
+		},
+		{
+			MethodName: "MoveDeck",
+			Handler:    _DeckService_MoveDeck_Handler,
+		},
+		{
+			MethodName: "ReadDeckDueCounts",
+			Handler:    _DeckService_ReadDeckDueCounts_Handler,
+		},
+		{
+			MethodName: "SearchAllPublicDecks",
+			Handler:    _DeckService_SearchAllPublicDecks_Handler,
+		},
+		{
+			MethodName: "SearchUserPublicDecks",
+			Handler:    _DeckService_SearchUserPublicDecks_Handler,
+		},
+		{
+			MethodName: "DeleteDeck",
+			Handler:    _DeckService_DeleteDeck_Handler,
+		},
+		{
+			MethodName: "ReadTrashedDecks",
+			Handler:    _DeckService_ReadTrashedDecks_Handler,
+		},
+		{
+			MethodName: "RestoreDeck",
+			Handler:    _DeckService_RestoreDeck_Handler,
+		},
+		{
+			MethodName: "CloneDeck",
+			Handler:    _DeckService_CloneDeck_Handler,
+		},
+		{
+			MethodName: "SubscribeDeck",
+			Handler:    _DeckService_SubscribeDeck_Handler,
+		},
+		{
+			MethodName: "UnsubscribeDeck",
+			Handler:    _DeckService_UnsubscribeDeck_Handler,
+		},
+		{
+			MethodName: "ReadDeckMembers",
+			Handler:    _DeckService_ReadDeckMembers_Handler,
+		},
+		{
+			MethodName: "InviteDeckMember",
+			Handler:    _DeckService_InviteDeckMember_Handler,
+		},
+		{
+			MethodName: "RemoveDeckMember",
+			Handler:    _DeckService_RemoveDeckMember_Handler,
+		},
+		{
+			MethodName: "AddCardToDeck",
+			Handler:    _DeckService_AddCardToDeck_Handler,
+		},
+		{
+			MethodName: "RemoveCardFromDeck",
+			Handler:    _DeckService_RemoveCardFromDeck_Handler,
+		},
+		{
+			MethodName: "MoveCards",
+			Handler:    _DeckService_MoveCards_Handler,
+		},
+		{
+			MethodName: "ReadCardsFromDeck",
+			Handler:    _DeckService_ReadCardsFromDeck_Handler,
+		},
+		{
+			MethodName: "ReadDeckOptions",
+			Handler:    _DeckService_ReadDeckOptions_Handler,
+		},
+		{
+			MethodName: "UpdateDeckOptions",
+			Handler:    _DeckService_UpdateDeckOptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deck/deck.proto",
+}
@@ -0,0 +1,678 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: stats/stats.proto
+
+package statsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimeRange int32
+
+const (
+	TimeRange_TIME_RANGE_UNSPECIFIED TimeRange = 0
+	TimeRange_DAILY                  TimeRange = 1
+	TimeRange_WEEKLY                 TimeRange = 2
+	TimeRange_MONTHLY                TimeRange = 3
+)
+
+// Enum value maps for TimeRange.
+var (
+	TimeRange_name = map[int32]string{
+		0: "TIME_RANGE_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+		3: "MONTHLY",
+	}
+	TimeRange_value = map[string]int32{
+		"TIME_RANGE_UNSPECIFIED": 0,
+		"DAILY":                  1,
+		"WEEKLY":                 2,
+		"MONTHLY":                3,
+	}
+)
+
+func (x TimeRange) Enum() *TimeRange {
+	p := new(TimeRange)
+	*p = x
+	return p
+}
+
+func (x TimeRange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeRange) Descriptor() protoreflect.EnumDescriptor {
+	return file_stats_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (TimeRange) Type() protoreflect.EnumType {
+	return &file_stats_stats_proto_enumTypes[0]
+}
+
+func (x TimeRange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeRange.Descriptor instead.
+func (TimeRange) EnumDescriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{0}
+}
+
+type GetAverageGradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // this field is optional
+	TimeRange     TimeRange              `protobuf:"varint,3,opt,name=time_range,json=timeRange,proto3,enum=stats.TimeRange" json:"time_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAverageGradeRequest) Reset() {
+	*x = GetAverageGradeRequest{}
+	mi := &file_stats_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAverageGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAverageGradeRequest) ProtoMessage() {}
+
+func (x *GetAverageGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAverageGradeRequest.ProtoReflect.Descriptor instead.
+func (*GetAverageGradeRequest) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAverageGradeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAverageGradeRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *GetAverageGradeRequest) GetTimeRange() TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return TimeRange_TIME_RANGE_UNSPECIFIED
+}
+
+type GetAverageGradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AverageGrade  float64                `protobuf:"fixed64,1,opt,name=average_grade,json=averageGrade,proto3" json:"average_grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAverageGradeResponse) Reset() {
+	*x = GetAverageGradeResponse{}
+	mi := &file_stats_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAverageGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAverageGradeResponse) ProtoMessage() {}
+
+func (x *GetAverageGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAverageGradeResponse.ProtoReflect.Descriptor instead.
+func (*GetAverageGradeResponse) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAverageGradeResponse) GetAverageGrade() float64 {
+	if x != nil {
+		return x.AverageGrade
+	}
+	return 0
+}
+
+type GetCardsReviewedCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // this field is optional
+	TimeRange     TimeRange              `protobuf:"varint,3,opt,name=time_range,json=timeRange,proto3,enum=stats.TimeRange" json:"time_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardsReviewedCountRequest) Reset() {
+	*x = GetCardsReviewedCountRequest{}
+	mi := &file_stats_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardsReviewedCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardsReviewedCountRequest) ProtoMessage() {}
+
+func (x *GetCardsReviewedCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardsReviewedCountRequest.ProtoReflect.Descriptor instead.
+func (*GetCardsReviewedCountRequest) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCardsReviewedCountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCardsReviewedCountRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *GetCardsReviewedCountRequest) GetTimeRange() TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return TimeRange_TIME_RANGE_UNSPECIFIED
+}
+
+type GetCardsReviewedCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewedCount int32                  `protobuf:"varint,1,opt,name=reviewed_count,json=reviewedCount,proto3" json:"reviewed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardsReviewedCountResponse) Reset() {
+	*x = GetCardsReviewedCountResponse{}
+	mi := &file_stats_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardsReviewedCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardsReviewedCountResponse) ProtoMessage() {}
+
+func (x *GetCardsReviewedCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardsReviewedCountResponse.ProtoReflect.Descriptor instead.
+func (*GetCardsReviewedCountResponse) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCardsReviewedCountResponse) GetReviewedCount() int32 {
+	if x != nil {
+		return x.ReviewedCount
+	}
+	return 0
+}
+
+type AddRecordingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CardId    string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	DeckId    string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Grade     int32                  `protobuf:"varint,4,opt,name=grade,proto3" json:"grade,omitempty"`
+	// Optional review id chosen by the caller, retries with the same key add the review once
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddRecordingRequest) Reset() {
+	*x = AddRecordingRequest{}
+	mi := &file_stats_stats_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecordingRequest) ProtoMessage() {}
+
+func (x *AddRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecordingRequest.ProtoReflect.Descriptor instead.
+func (*AddRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddRecordingRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *AddRecordingRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *AddRecordingRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AddRecordingRequest) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *AddRecordingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type AddRecordingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRecordingResponse) Reset() {
+	*x = AddRecordingResponse{}
+	mi := &file_stats_stats_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecordingResponse) ProtoMessage() {}
+
+func (x *AddRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecordingResponse.ProtoReflect.Descriptor instead.
+func (*AddRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddRecordingResponse) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type DeleteRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordingRequest) Reset() {
+	*x = DeleteRecordingRequest{}
+	mi := &file_stats_stats_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordingRequest) ProtoMessage() {}
+
+func (x *DeleteRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRecordingRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type GetCardsLearnedCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeckId        string                 `protobuf:"bytes,2,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"` // this field is optional
+	TimeRange     TimeRange              `protobuf:"varint,3,opt,name=time_range,json=timeRange,proto3,enum=stats.TimeRange" json:"time_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardsLearnedCountRequest) Reset() {
+	*x = GetCardsLearnedCountRequest{}
+	mi := &file_stats_stats_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardsLearnedCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardsLearnedCountRequest) ProtoMessage() {}
+
+func (x *GetCardsLearnedCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardsLearnedCountRequest.ProtoReflect.Descriptor instead.
+func (*GetCardsLearnedCountRequest) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCardsLearnedCountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCardsLearnedCountRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *GetCardsLearnedCountRequest) GetTimeRange() TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return TimeRange_TIME_RANGE_UNSPECIFIED
+}
+
+type GetCardsLearnedCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LearnedCount  int32                  `protobuf:"varint,1,opt,name=learned_count,json=learnedCount,proto3" json:"learned_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardsLearnedCountResponse) Reset() {
+	*x = GetCardsLearnedCountResponse{}
+	mi := &file_stats_stats_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardsLearnedCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardsLearnedCountResponse) ProtoMessage() {}
+
+func (x *GetCardsLearnedCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_stats_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardsLearnedCountResponse.ProtoReflect.Descriptor instead.
+func (*GetCardsLearnedCountResponse) Descriptor() ([]byte, []int) {
+	return file_stats_stats_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCardsLearnedCountResponse) GetLearnedCount() int32 {
+	if x != nil {
+		return x.LearnedCount
+	}
+	return 0
+}
+
+var File_stats_stats_proto protoreflect.FileDescriptor
+
+const file_stats_stats_proto_rawDesc = "" +
+	"\n" +
+	"\x11stats/stats.proto\x12\x05stats\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"{\n" +
+	"\x16GetAverageGradeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\x12/\n" +
+	"\n" +
+	"time_range\x18\x03 \x01(\x0e2\x10.stats.TimeRangeR\ttimeRange\">\n" +
+	"\x17GetAverageGradeResponse\x12#\n" +
+	"\raverage_grade\x18\x01 \x01(\x01R\faverageGrade\"\x81\x01\n" +
+	"\x1cGetCardsReviewedCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\x12/\n" +
+	"\n" +
+	"time_range\x18\x03 \x01(\x0e2\x10.stats.TimeRangeR\ttimeRange\"F\n" +
+	"\x1dGetCardsReviewedCountResponse\x12%\n" +
+	"\x0ereviewed_count\x18\x01 \x01(\x05R\rreviewedCount\"\xc1\x01\n" +
+	"\x13AddRecordingRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05grade\x18\x04 \x01(\x05R\x05grade\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"3\n" +
+	"\x14AddRecordingResponse\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\"5\n" +
+	"\x16DeleteRecordingRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\"\x80\x01\n" +
+	"\x1bGetCardsLearnedCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\adeck_id\x18\x02 \x01(\tR\x06deckId\x12/\n" +
+	"\n" +
+	"time_range\x18\x03 \x01(\x0e2\x10.stats.TimeRangeR\ttimeRange\"C\n" +
+	"\x1cGetCardsLearnedCountResponse\x12#\n" +
+	"\rlearned_count\x18\x01 \x01(\x05R\flearnedCount*K\n" +
+	"\tTimeRange\x12\x1a\n" +
+	"\x16TIME_RANGE_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02\x12\v\n" +
+	"\aMONTHLY\x10\x032\xb7\x03\n" +
+	"\vStatService\x12P\n" +
+	"\x0fGetAverageGrade\x12\x1d.stats.GetAverageGradeRequest\x1a\x1e.stats.GetAverageGradeResponse\x12b\n" +
+	"\x15GetCardsReviewedCount\x12#.stats.GetCardsReviewedCountRequest\x1a$.stats.GetCardsReviewedCountResponse\x12G\n" +
+	"\fAddRecording\x12\x1a.stats.AddRecordingRequest\x1a\x1b.stats.AddRecordingResponse\x12H\n" +
+	"\x0fDeleteRecording\x12\x1d.stats.DeleteRecordingRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x14GetCardsLearnedCount\x12\".stats.GetCardsLearnedCountRequest\x1a#.stats.GetCardsLearnedCountResponseB9Z7github.com/GOeda-Co/proto-contract/gen/go/stats;statsv1b\x06proto3"
+
+var (
+	file_stats_stats_proto_rawDescOnce sync.Once
+	file_stats_stats_proto_rawDescData []byte
+)
+
+func file_stats_stats_proto_rawDescGZIP() []byte {
+	file_stats_stats_proto_rawDescOnce.Do(func() {
+		file_stats_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stats_stats_proto_rawDesc), len(file_stats_stats_proto_rawDesc)))
+	})
+	return file_stats_stats_proto_rawDescData
+}
+
+var file_stats_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stats_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_stats_stats_proto_goTypes = []any{
+	(TimeRange)(0),                        // 0: stats.TimeRange
+	(*GetAverageGradeRequest)(nil),        // 1: stats.GetAverageGradeRequest
+	(*GetAverageGradeResponse)(nil),       // 2: stats.GetAverageGradeResponse
+	(*GetCardsReviewedCountRequest)(nil),  // 3: stats.GetCardsReviewedCountRequest
+	(*GetCardsReviewedCountResponse)(nil), // 4: stats.GetCardsReviewedCountResponse
+	(*AddRecordingRequest)(nil),           // 5: stats.AddRecordingRequest
+	(*AddRecordingResponse)(nil),          // 6: stats.AddRecordingResponse
+	(*DeleteRecordingRequest)(nil),        // 7: stats.DeleteRecordingRequest
+	(*GetCardsLearnedCountRequest)(nil),   // 8: stats.GetCardsLearnedCountRequest
+	(*GetCardsLearnedCountResponse)(nil),  // 9: stats.GetCardsLearnedCountResponse
+	(*timestamppb.Timestamp)(nil),         // 10: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 11: google.protobuf.Empty
+}
+var file_stats_stats_proto_depIdxs = []int32{
+	0,  // 0: stats.GetAverageGradeRequest.time_range:type_name -> stats.TimeRange
+	0,  // 1: stats.GetCardsReviewedCountRequest.time_range:type_name -> stats.TimeRange
+	10, // 2: stats.AddRecordingRequest.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: stats.GetCardsLearnedCountRequest.time_range:type_name -> stats.TimeRange
+	1,  // 4: stats.StatService.GetAverageGrade:input_type -> stats.GetAverageGradeRequest
+	3,  // 5: stats.StatService.GetCardsReviewedCount:input_type -> stats.GetCardsReviewedCountRequest
+	5,  // 6: stats.StatService.AddRecording:input_type -> stats.AddRecordingRequest
+	7,  // 7: stats.StatService.DeleteRecording:input_type -> stats.DeleteRecordingRequest
+	8,  // 8: stats.StatService.GetCardsLearnedCount:input_type -> stats.GetCardsLearnedCountRequest
+	2,  // 9: stats.StatService.GetAverageGrade:output_type -> stats.GetAverageGradeResponse
+	4,  // 10: stats.StatService.GetCardsReviewedCount:output_type -> stats.GetCardsReviewedCountResponse
+	6,  // 11: stats.StatService.AddRecording:output_type -> stats.AddRecordingResponse
+	11, // 12: stats.StatService.DeleteRecording:output_type -> google.protobuf.Empty
+	9,  // 13: stats.StatService.GetCardsLearnedCount:output_type -> stats.GetCardsLearnedCountResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_stats_stats_proto_init() }
+func file_stats_stats_proto_init() {
+	if File_stats_stats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stats_stats_proto_rawDesc), len(file_stats_stats_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stats_stats_proto_goTypes,
+		DependencyIndexes: file_stats_stats_proto_depIdxs,
+		EnumInfos:         file_stats_stats_proto_enumTypes,
+		MessageInfos:      file_stats_stats_proto_msgTypes,
+	}.Build()
+	File_stats_stats_proto = out.File
+	file_stats_stats_proto_goTypes = nil
+	file_stats_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,7 @@
+package model
+
+type App struct {
+	ID     int
+	Name   string
+	Secret string
+}
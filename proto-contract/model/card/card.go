@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	user "github.com/GOeda-Co/proto-contract/model/user"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// NOTE: I don't use rn gorm.Model due to redundancy of some fields
+
+type Card struct {
+	CardId           uuid.UUID      `gorm:"type:uuid;primaryKey;" json:"card_id"`
+	CreatedBy        uuid.UUID      `gorm:"type:uuid" json:"created_by"`
+	User             user.User      `gorm:"foreignKey:CreatedBy;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+	CreatedAt        time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	Word             string         `gorm:"type:varchar(100);not null;default:null" json:"word"`
+	Translation      string         `gorm:"type:varchar(100);not null;default:''" json:"translation"`
+	Easiness         float64        `gorm:"type:double precision;not null;default:2.5" json:"easiness"`
+	UpdatedAt        time.Time      `gorm:"autoCreateTime" json:"updated_at"`
+	Interval         int            `gorm:"type:integer;default=0" json:"interval"` // in days
+	ExpiresAt        time.Time      `json:"expires_at"`
+	RepetitionNumber int            `gorm:"type:smallint;default=0" json:"repetition_number"`
+	DeckID           uuid.UUID      `gorm:"type:uuid;index" json:"deck_id"`
+	Tags             pq.StringArray `gorm:"type:text[]" json:"tags"`
+	IsPublic         bool           `gorm:"default:false" json:"is_public"`
+	Stability        float64        `gorm:"type:double precision;not null;default:0" json:"stability"`
+	Difficulty       float64        `gorm:"type:double precision;not null;default:0" json:"difficulty"`
+	Lapses           int            `gorm:"type:smallint;default:0" json:"lapses"`
+	LastReviewedAt   *time.Time     `json:"last_reviewed_at"`
+	Algorithm        string         `gorm:"type:varchar(16);not null;default:'sm2'" json:"algorithm"`
+	Phase            string         `gorm:"type:varchar(16);not null;default:'new'" json:"phase"`        // new, learning, review or relearning
+	Step             int            `gorm:"type:smallint;default:0" json:"step"`                         // current (re)learning step
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"deleted_at"`                                     // set while the card is in trash
+	SourceCardId     *uuid.UUID     `gorm:"type:uuid;index" json:"source_card_id,omitempty"`             // card this one was cloned from
+	KeepLocal        bool           `gorm:"not null;default:false" json:"keep_local"`                    // upstream changes of the source card are not merged
+	Template         string         `gorm:"type:varchar(16);not null;default:'forward'" json:"template"` // side asked: forward, reverse or cloze
+	NoteId           *uuid.UUID     `gorm:"type:uuid;index" json:"note_id,omitempty"`                    // shared by sibling cards added together
+	BuriedUntil      *time.Time     `json:"buried_until,omitempty"`                                      // left out of the study queue until then after a sibling was reviewed
+	Ordinal          int            `gorm:"type:smallint;not null;default:0" json:"ordinal,omitempty"`   // cloze index asked by a cloze card
+
+	// Other cards added together with this one, filled by AddCard only
+	Siblings []Card `gorm:"-" json:"siblings,omitempty"`
+
+	// Text asked and shown after answering, rendered by Sides
+	Front string `gorm:"-" json:"front"`
+	Back  string `gorm:"-" json:"back"`
+
+	// Filled by full-text search only, matches are wrapped in <b></b>
+	Rank                 float32 `gorm:"->;-:migration" json:"rank,omitempty"`
+	WordHighlight        string  `gorm:"->;-:migration" json:"word_highlight,omitempty"`
+	TranslationHighlight string  `gorm:"->;-:migration" json:"translation_highlight,omitempty"`
+}
+
+func (c *Card) BeforeCreate(tx *gorm.DB) error {
+	if c.CardId == uuid.Nil {
+		c.CardId = uuid.New()
+	}
+	if c.ExpiresAt.IsZero() {
+		c.ExpiresAt = time.Now().Add(10 * time.Second)
+	}
+	if c.Template == "" {
+		c.Template = TemplateForward
+	}
+	return nil
+}
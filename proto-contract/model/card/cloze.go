@@ -0,0 +1,70 @@
+package model
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Cloze deletions are written as {{c1::answer}} or {{c1::answer::hint}}, the
+// number is the cloze index, from 1 to 9999. Deletions sharing an index are
+// asked together
+var clozePattern = regexp.MustCompile(`\{\{c([1-9][0-9]{0,3})::(.*?)(?:::(.*?))?\}\}`)
+
+// ClozeIndexes returns cloze indexes used in the text in ascending order,
+// without repeats. Text without cloze deletions has none
+func ClozeIndexes(text string) []int {
+	var indexes []int
+	for _, match := range clozePattern.FindAllStringSubmatch(text, -1) {
+		index, err := strconv.Atoi(match[1])
+		if err != nil || slices.Contains(indexes, index) {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes
+}
+
+// RenderCloze renders the text asking deletions of the index. On the front
+// they are hidden as [...], or as [hint] when the deletion has a hint, other
+// deletions show their answers. On the back every answer is shown
+func RenderCloze(text string, index int) (front, back string) {
+	var f, b strings.Builder
+	last := 0
+	for _, match := range clozePattern.FindAllStringSubmatchIndex(text, -1) {
+		f.WriteString(text[last:match[0]])
+		b.WriteString(text[last:match[0]])
+		answer := text[match[4]:match[5]]
+		asked, _ := strconv.Atoi(text[match[2]:match[3]])
+		switch {
+		case asked != index:
+			f.WriteString(answer)
+		case match[6] >= 0:
+			f.WriteString("[" + text[match[6]:match[7]] + "]")
+		default:
+			f.WriteString("[...]")
+		}
+		b.WriteString(answer)
+		last = match[1]
+	}
+	f.WriteString(text[last:])
+	b.WriteString(text[last:])
+	return f.String(), b.String()
+}
+
+// Sides renders text asked on the front of the card and shown on its back
+func (c *Card) Sides() (front, back string) {
+	switch c.Template {
+	case TemplateReverse:
+		return c.Translation, c.Word
+	case TemplateCloze:
+		front, back = RenderCloze(c.Word, c.Ordinal)
+		if c.Translation != "" {
+			back += "\n" + c.Translation
+		}
+		return front, back
+	}
+	return c.Word, c.Translation
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// What happened to a card of a shared deck
+const (
+	DeckChangeAdd    = "add"
+	DeckChangeEdit   = "edit"
+	DeckChangeDelete = "delete"
+)
+
+// DeckChange is one entry of the changelog of a shared deck. Publishing the deck
+// records how its cards changed since the previous version, subscribers merge
+// changes of versions they haven't seen yet into their copies
+type DeckChange struct {
+	DeckChangeId uuid.UUID      `gorm:"type:uuid;primaryKey" json:"deck_change_id"`
+	DeckId       uuid.UUID      `gorm:"type:uuid;index:idx_deck_changes_deck_version" json:"deck_id"`
+	Version      int            `gorm:"not null;index:idx_deck_changes_deck_version" json:"version"`
+	CardId       uuid.UUID      `gorm:"type:uuid" json:"card_id"` // card of the shared deck
+	Action       string         `gorm:"type:varchar(16);not null" json:"action"`
+	Word         string         `gorm:"type:varchar(100)" json:"word,omitempty"` // content after the change, empty for deletions
+	Translation  string         `gorm:"type:varchar(100)" json:"translation,omitempty"`
+	Tags         pq.StringArray `gorm:"type:text[]" json:"tags,omitempty"`
+	Template     string         `gorm:"type:varchar(16)" json:"template,omitempty"` // template, note and cloze index of the card, so subscribers keep siblings together
+	NoteId       *uuid.UUID     `gorm:"type:uuid" json:"note_id,omitempty"`
+	Ordinal      int            `gorm:"type:smallint;not null;default:0" json:"ordinal,omitempty"`
+	PublishedAt  time.Time      `json:"published_at"`
+}
+
+func (c *DeckChange) BeforeCreate(tx *gorm.DB) error {
+	if c.DeckChangeId == uuid.Nil {
+		c.DeckChangeId = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Kinds of outbox events
+const (
+	OutboxReviewAdded   = "review_added"
+	OutboxReviewDeleted = "review_deleted"
+)
+
+// OutboxEvent is a review change waiting to be delivered to stats service.
+// It is written in the same transaction as the card, ReviewId doubles as
+// idempotency key of the delivery
+type OutboxEvent struct {
+	EventId       uuid.UUID  `gorm:"type:uuid;primaryKey" json:"event_id"`
+	Kind          string     `gorm:"type:varchar(32);not null" json:"kind"`
+	UserId        uuid.UUID  `gorm:"type:uuid" json:"user_id"`
+	DeckId        uuid.UUID  `gorm:"type:uuid" json:"deck_id"`
+	CardId        uuid.UUID  `gorm:"type:uuid" json:"card_id"`
+	ReviewId      uuid.UUID  `gorm:"type:uuid" json:"review_id"`
+	Grade         int        `gorm:"type:smallint" json:"grade"`
+	OccurredAt    time.Time  `gorm:"index" json:"occurred_at"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	DeliveredAt   *time.Time `gorm:"index" json:"delivered_at"`
+	LastError     string     `gorm:"type:text" json:"last_error"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox"
+}
+
+func (e *OutboxEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.EventId == uuid.Nil {
+		e.EventId = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Preference keeps per-user scheduling settings of the card service
+type Preference struct {
+	UserId    uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	Algorithm string    `gorm:"type:varchar(16);not null;default:'sm2'" json:"algorithm"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
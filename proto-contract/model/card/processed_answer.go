@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProcessedAnswer remembers an answer submitted with a client request ID and its
+// outcome, so that a retry of the same request returns the original result
+type ProcessedAnswer struct {
+	UserId       uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	RequestId    string    `gorm:"type:varchar(128);primaryKey" json:"request_id"`
+	CardId       uuid.UUID `gorm:"type:uuid" json:"card_id"`
+	ReviewId     string    `gorm:"type:varchar(64)" json:"review_id"`
+	NextReviewAt time.Time `json:"next_review_at"`
+	CreatedAt    time.Time `gorm:"index" json:"created_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailyProgress counts cards the user studied in a deck during one day,
+// so daily limits of the deck survive reloading the study queue
+type DailyProgress struct {
+	UserId        uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	DeckId        uuid.UUID `gorm:"type:uuid;primaryKey" json:"deck_id"`
+	Day           time.Time `gorm:"type:date;primaryKey" json:"day"`
+	NewIntroduced int       `gorm:"not null;default:0" json:"new_introduced"`
+	ReviewsDone   int       `gorm:"not null;default:0" json:"reviews_done"`
+}
+
+func (DailyProgress) TableName() string {
+	return "daily_progress"
+}
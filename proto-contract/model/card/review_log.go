@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReviewLog keeps scheduling state of the card before an answer, so the
+// answer can be undone
+type ReviewLog struct {
+	ReviewLogId uuid.UUID `gorm:"type:uuid;primaryKey" json:"review_log_id"`
+	UserId      uuid.UUID `gorm:"type:uuid;index" json:"user_id"`
+	CardId      uuid.UUID `gorm:"type:uuid" json:"card_id"`
+	DeckId      uuid.UUID `gorm:"type:uuid" json:"deck_id"`
+	ReviewId    string    `gorm:"type:varchar(36)" json:"review_id"` // review row in stats service
+	Grade       int       `gorm:"type:smallint" json:"grade"`
+	ReviewedAt  time.Time `gorm:"index" json:"reviewed_at"`
+
+	Interval         int        `json:"interval"`
+	Easiness         float64    `json:"easiness"`
+	RepetitionNumber int        `json:"repetition_number"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	Stability        float64    `json:"stability"`
+	Difficulty       float64    `json:"difficulty"`
+	Lapses           int        `json:"lapses"`
+	LastReviewedAt   *time.Time `json:"last_reviewed_at"`
+	Algorithm        string     `gorm:"type:varchar(16)" json:"algorithm"`
+	Phase            string     `gorm:"type:varchar(16)" json:"phase"`
+	Step             int        `json:"step"`
+}
+
+func (r *ReviewLog) BeforeCreate(tx *gorm.DB) error {
+	if r.ReviewLogId == uuid.Nil {
+		r.ReviewLogId = uuid.New()
+	}
+	return nil
+}
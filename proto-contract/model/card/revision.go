@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// CardRevision is one entry of card edit history. It keeps what changed
+// together with a snapshot of card content after the change, so the card
+// can be reverted to any revision
+type CardRevision struct {
+	RevisionId   uuid.UUID      `gorm:"type:uuid;primaryKey" json:"revision_id"`
+	CardId       uuid.UUID      `gorm:"type:uuid;uniqueIndex:idx_card_revisions_card_revision" json:"card_id"`
+	Revision     int            `gorm:"uniqueIndex:idx_card_revisions_card_revision" json:"revision"`
+	EditedBy     uuid.UUID      `gorm:"type:uuid" json:"edited_by"`
+	EditedAt     time.Time      `json:"edited_at"`
+	Changes      []FieldChange  `gorm:"type:jsonb;serializer:json" json:"changes"`
+	RevertedFrom int            `gorm:"default:0" json:"reverted_from,omitempty"` // revision restored by this one
+	Word         string         `gorm:"type:varchar(100)" json:"word"`
+	Translation  string         `gorm:"type:varchar(100)" json:"translation"`
+	Tags         pq.StringArray `gorm:"type:text[]" json:"tags"`
+	IsPublic     bool           `json:"is_public"`
+}
+
+// FieldChange is a change of one card field, values are formatted as text
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+func (r *CardRevision) BeforeCreate(tx *gorm.DB) error {
+	if r.RevisionId == uuid.Nil {
+		r.RevisionId = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package model
+
+// TagCount is a tag of the user with the number of cards having it
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
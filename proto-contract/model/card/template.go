@@ -0,0 +1,21 @@
+package model
+
+// Templates decide which side of a card is asked. A card added with
+// TemplateBoth is stored as a forward and a reverse card sharing a note,
+// each with its own scheduling. A cloze card is stored as one card for each
+// cloze index of its text, see RenderCloze
+const (
+	TemplateForward = "forward" // word on the front, translation on the back
+	TemplateReverse = "reverse" // translation on the front, word on the back
+	TemplateBoth    = "both"    // only when adding a card
+	TemplateCloze   = "cloze"   // word with cloze deletions asked one index at a time, translation is extra on the back
+)
+
+// ValidTemplate reports whether a card can be added with the template
+func ValidTemplate(template string) bool {
+	switch template {
+	case "", TemplateForward, TemplateReverse, TemplateBoth, TemplateCloze:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	// "repeatro/internal/models"
+	card "github.com/GOeda-Co/proto-contract/model/card"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// What happens to cards of a deleted deck
+const (
+	DeleteModeCards  = "delete" // cards go to trash with the deck
+	DeleteModeMove   = "move"   // cards are moved to another deck of the user
+	DeleteModeDetach = "detach" // cards stay without a deck
+)
+
+type Deck struct {
+	DeckId        uuid.UUID      `gorm:"type:uuid;primaryKey;" json:"deck_id"`
+	CreatedBy     uuid.UUID      `gorm:"references:UserId;constraint:OnDelete:CASCADE;" json:"created_by"`
+	CreatedAt     time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	Name          string         `gorm:"type:varchar(100);not null;default:null" json:"name"`
+	Description   string         `gorm:"type:varchar(100);" json:"description"`
+	CardsQuantity uint           `gorm:"default=0" json:"cards_quantity"`
+	Cards         []card.Card    `gorm:"foreignKey:CardId;constraint:OnDelete:CASCADE"`
+	IsPublic      bool           `gorm:"default:false" json:"is_public"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"deleted_at"`                         // set while the deck is in trash
+	SourceDeckId  *uuid.UUID     `gorm:"type:uuid;index" json:"source_deck_id,omitempty"` // deck this one was cloned from
+	Version       int            `gorm:"not null;default:0" json:"version"`               // last published version, 0 until the deck is published
+	Subscribed    bool           `gorm:"not null;default:false" json:"subscribed"`        // copy follows updates of the source deck
+	SyncedVersion int            `gorm:"not null;default:0" json:"synced_version"`        // version of the source deck merged into the copy
+	ParentDeckId  *uuid.UUID     `gorm:"type:uuid;index" json:"parent_deck_id,omitempty"` // deck this one is nested in, nil for top-level decks
+
+	// Filled by full-text search only, matches are wrapped in <b></b>
+	Rank                 float32 `gorm:"->;-:migration" json:"rank,omitempty"`
+	NameHighlight        string  `gorm:"->;-:migration" json:"name_highlight,omitempty"`
+	DescriptionHighlight string  `gorm:"->;-:migration" json:"description_highlight,omitempty"`
+
+	// Role of the user reading the deck, owner for its author
+	Role string `gorm:"->;-:migration" json:"role,omitempty"`
+}
+
+func (d *Deck) BeforeCreate(tx *gorm.DB) error {
+	if d.DeckId == uuid.Nil {
+		d.DeckId = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles of deck members, each includes what the ones before it allow
+const (
+	RoleViewer = "viewer" // reads the deck, its cards and options
+	RoleEditor = "editor" // adds, edits and removes cards of the deck
+	RoleOwner  = "owner"  // changes the deck and its options, manages members
+)
+
+var roleRanks = map[string]int{RoleViewer: 1, RoleEditor: 2, RoleOwner: 3}
+
+// Member gives a user other than the author access to the deck. The author
+// of the deck is its owner without being a member
+type Member struct {
+	DeckId    uuid.UUID `gorm:"type:uuid;primaryKey" json:"deck_id"`
+	UserId    uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"user_id"`
+	Role      string    `gorm:"type:varchar(16);not null" json:"role"`
+	InvitedBy uuid.UUID `gorm:"type:uuid" json:"invited_by"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (Member) TableName() string {
+	return "deck_members"
+}
+
+// ValidRole reports whether role is one of the member roles
+func ValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// RoleAllows reports whether role grants what required does. Empty role grants nothing
+func RoleAllows(role string, required string) bool {
+	return role != "" && roleRanks[role] >= roleRanks[required]
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	DefaultGraduatingInterval = 1     // in days
+	DefaultEasyInterval       = 4     // in days
+	DefaultMaximumInterval    = 36500 // in days
+	DefaultNewPerDay          = 20
+	DefaultMaxReviewsPerDay   = 200
+)
+
+// Order in which new cards of the deck are introduced
+const (
+	NewCardOrderAdded  = "added"
+	NewCardOrderRandom = "random"
+)
+
+// Options are per-deck study settings. Card service reads them when
+// rescheduling cards of the deck
+type Options struct {
+	DeckId             uuid.UUID     `gorm:"type:uuid;primaryKey" json:"deck_id"`
+	LearningSteps      pq.Int64Array `gorm:"type:integer[]" json:"learning_steps"`   // in minutes
+	RelearningSteps    pq.Int64Array `gorm:"type:integer[]" json:"relearning_steps"` // in minutes
+	GraduatingInterval int           `gorm:"not null;default:1" json:"graduating_interval"`
+	EasyInterval       int           `gorm:"not null;default:4" json:"easy_interval"`
+	MaximumInterval    int           `gorm:"not null;default:36500" json:"maximum_interval"`
+	UpdatedAt          time.Time     `gorm:"autoUpdateTime" json:"updated_at"`
+	NewPerDay          int           `gorm:"not null;default:20" json:"new_per_day"`
+	MaxReviewsPerDay   int           `gorm:"not null;default:200" json:"max_reviews_per_day"`
+	Algorithm          string        `gorm:"type:varchar(16)" json:"algorithm"` // overrides user preference when set
+	BurySiblings       bool          `gorm:"not null;default:false" json:"bury_siblings"`
+	NewCardOrder       string        `gorm:"type:varchar(16);not null;default:'added'" json:"new_card_order"`
+}
+
+func (Options) TableName() string {
+	return "deck_options"
+}
+
+// DefaultOptions mirrors Anki defaults: 1m 10m learning steps, 10m relearning step,
+// 20 new cards and 200 reviews a day
+func DefaultOptions(deckId uuid.UUID) Options {
+	return Options{
+		DeckId:             deckId,
+		LearningSteps:      pq.Int64Array{1, 10},
+		RelearningSteps:    pq.Int64Array{10},
+		GraduatingInterval: DefaultGraduatingInterval,
+		EasyInterval:       DefaultEasyInterval,
+		MaximumInterval:    DefaultMaximumInterval,
+		NewPerDay:          DefaultNewPerDay,
+		MaxReviewsPerDay:   DefaultMaxReviewsPerDay,
+		NewCardOrder:       NewCardOrderAdded,
+	}
+}
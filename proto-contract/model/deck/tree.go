@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+// DueCount is the number of the user's cards due today in a deck by learning
+// phase, in the deck alone and together with all of its subdecks
+type DueCount struct {
+	DeckId       uuid.UUID  `json:"deck_id"`
+	ParentDeckId *uuid.UUID `json:"parent_deck_id,omitempty"`
+	Name         string     `json:"name"`
+
+	New      int `json:"new"`
+	Learning int `json:"learning"` // learning and relearning
+	Review   int `json:"review"`
+
+	TotalNew      int `json:"total_new"`
+	TotalLearning int `json:"total_learning"`
+	TotalReview   int `json:"total_review"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name     string
+	Email    string
+	PassHash []byte
+	IsAdmin  bool
+}
+
+func (u *User) BeforeCreate(tx *gorm.DB) error {
+	u.ID = uuid.New()
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,138 @@
+// Package pagination implements keyset (cursor) pagination shared by list RPCs.
+// Lists are ordered newest first by (created_at, id), search results by
+// (rank, created_at, id). A cursor points at the last item of the previous page
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the sort key of the last item on a page
+type Cursor struct {
+	CreatedAt time.Time
+	Id        uuid.UUID
+	Rank      float32 // search relevance, zero for plain lists
+}
+
+// Page is a request for items after the cursor, the first page has no cursor
+type Page struct {
+	After *Cursor
+	Limit int
+}
+
+// New parses the cursor and clamps limit to (0, MaxLimit], zero means DefaultLimit
+func New(cursor string, limit int) (Page, error) {
+	page := Page{Limit: limit}
+	if page.Limit <= 0 {
+		page.Limit = DefaultLimit
+	}
+	if page.Limit > MaxLimit {
+		page.Limit = MaxLimit
+	}
+
+	if cursor == "" {
+		return page, nil
+	}
+	after, err := Decode(cursor)
+	if err != nil {
+		return Page{}, err
+	}
+	page.After = &after
+	return page, nil
+}
+
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%s,%s", c.CreatedAt.UTC().Format(time.RFC3339Nano), c.Id)
+	if c.Rank != 0 {
+		raw += "," + strconv.FormatFloat(float64(c.Rank), 'g', -1, 32)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func Decode(cursor string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ",")
+	if len(parts) != 2 && len(parts) != 3 {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if c.CreatedAt, err = time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if c.Id, err = uuid.Parse(parts[1]); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if len(parts) == 3 {
+		rank, err := strconv.ParseFloat(parts[2], 32)
+		if err != nil {
+			return Cursor{}, ErrInvalidCursor
+		}
+		c.Rank = float32(rank)
+	}
+	return c, nil
+}
+
+// Scope orders the query by the keyset columns and selects one item more than
+// the limit, so Trim can tell whether there is a next page
+func (p Page) Scope(createdAtColumn, idColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if p.After != nil {
+			db = db.Where(
+				fmt.Sprintf("(%s, %s) < (?, ?)", createdAtColumn, idColumn),
+				p.After.CreatedAt, p.After.Id,
+			)
+		}
+		return db.
+			Order(createdAtColumn + " DESC").
+			Order(idColumn + " DESC").
+			Limit(p.Limit + 1)
+	}
+}
+
+// RankedScope is Scope for search results ordered by relevance first.
+// rankExpr is an SQL expression of type real, it can't be a select alias as it
+// is used in WHERE as well
+func (p Page) RankedScope(rankExpr, createdAtColumn, idColumn string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if p.After != nil {
+			db = db.Where(
+				fmt.Sprintf("(%s, %s, %s) < (CAST(? AS real), ?, ?)", rankExpr, createdAtColumn, idColumn),
+				p.After.Rank, p.After.CreatedAt, p.After.Id,
+			)
+		}
+		return db.
+			Order(rankExpr + " DESC").
+			Order(createdAtColumn + " DESC").
+			Order(idColumn + " DESC").
+			Limit(p.Limit + 1)
+	}
+}
+
+// Trim cuts items selected with Scope to the page and returns the cursor of
+// the next page, empty on the last one
+func Trim[T any](items []T, p Page, key func(T) Cursor) ([]T, string) {
+	if len(items) <= p.Limit {
+		return items, ""
+	}
+	items = items[:p.Limit]
+	return items, key(items[len(items)-1]).Encode()
+}
@@ -0,0 +1,178 @@
+package scheme
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/GOeda-Co/proto-contract/model/card"
+)
+
+type AnswerScheme struct {
+	CardId    uuid.UUID `json:"card_id"`
+	Grade     int       `json:"grade"`
+	RequestId string    `json:"request_id,omitempty"`
+	// AnsweredAt is when the answer was given, required when syncing offline answers
+	AnsweredAt time.Time `json:"answered_at,omitempty"`
+}
+
+type AnswerResult struct {
+	CardId       uuid.UUID `json:"card_id"`
+	RequestId    string    `json:"request_id,omitempty"`
+	ReviewId     string    `json:"review_id,omitempty"`
+	NextReviewAt time.Time `json:"next_review_at"`
+	Duplicate    bool      `json:"duplicate"`
+}
+
+type SyncConflict struct {
+	CardId    uuid.UUID `json:"card_id"`
+	RequestId string    `json:"request_id,omitempty"`
+	Reason    string    `json:"reason"`
+}
+
+// SyncResult is the outcome of replaying offline answers
+type SyncResult struct {
+	Cards     []model.Card   `json:"cards"`
+	Results   []AnswerResult `json:"results"`
+	Conflicts []SyncConflict `json:"conflicts"`
+}
+
+type UpdateCardScheme struct {
+	Word             string         `json:"word"`
+	Translation      string         `json:"translation"`
+	Easiness         float64        `json:"easiness"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	Interval         int            `json:"interval"`
+	ExpiresAt        time.Time      `json:"expires_at"`
+	RepetitionNumber int            `json:"repetition_number"`
+	Tags             pq.StringArray `json:"tags"`
+	IsPublic         bool           `json:"is_public"`
+}
+
+type TagsScheme struct {
+	Tags []string `json:"tags" binding:"required,min=1"`
+}
+
+// RetagCardsScheme adds and removes tags on several cards at once
+type RetagCardsScheme struct {
+	CardIds []uuid.UUID `json:"card_ids" binding:"required,min=1"`
+	Add     []string    `json:"add"`
+	Remove  []string    `json:"remove"`
+}
+
+// MoveCardsScheme moves several cards into a deck at once
+type MoveCardsScheme struct {
+	CardIds []uuid.UUID `json:"card_ids" binding:"required,min=1"`
+	DeckId  uuid.UUID   `json:"deck_id" binding:"required"`
+}
+
+type RenameTagScheme struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type UpdatePreferencesScheme struct {
+	Algorithm string `json:"algorithm" binding:"required,oneof=sm2 fsrs"`
+}
+
+// ImportRow is a row of an imported file, Line is its number in the file
+type ImportRow struct {
+	Line        int      `json:"line"`
+	Word        string   `json:"word"`
+	Translation string   `json:"translation"`
+	Tags        []string `json:"tags"`
+
+	Schedule *ImportSchedule `json:"schedule,omitempty"` // nil for new cards
+	Reviews  []Review        `json:"reviews,omitempty"`
+}
+
+// Review is an answer given to a card with the state of the card before it
+type Review struct {
+	CardId     uuid.UUID `json:"card_id"`
+	Grade      int       `json:"grade"`
+	ReviewedAt time.Time `json:"reviewed_at"`
+	Phase      string    `json:"phase"`
+	Interval   int       `json:"interval"`
+	Easiness   float64   `json:"easiness"`
+	Stability  float64   `json:"stability"`
+	Difficulty float64   `json:"difficulty"`
+}
+
+// ImportSchedule is scheduling state of a card imported from another app
+type ImportSchedule struct {
+	Phase            string     `json:"phase"` // learning, review or relearning
+	Interval         int        `json:"interval"`
+	Easiness         float64    `json:"easiness"`
+	RepetitionNumber int        `json:"repetition_number"`
+	Lapses           int        `json:"lapses"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	LastReviewedAt   *time.Time `json:"last_reviewed_at"`
+	Stability        float64    `json:"stability"`
+	Difficulty       float64    `json:"difficulty"`
+}
+
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// ImportResult reports how many rows became cards and why the others did not.
+// Duplicates are counted separately but reported among errors too
+type ImportResult struct {
+	Imported   int              `json:"imported"`
+	Duplicates int              `json:"duplicates"`
+	Errors     []ImportRowError `json:"errors"`
+}
+
+// PublishResult is the version a deck got when published and how its cards
+// changed since the previous version
+type PublishResult struct {
+	Version int `json:"version"`
+	Added   int `json:"added"`
+	Edited  int `json:"edited"`
+	Deleted int `json:"deleted"`
+}
+
+// DeckSyncResult reports how changes of the source deck were merged into a copy.
+// Kept counts changes skipped for cards the subscriber edited or deleted
+type DeckSyncResult struct {
+	Version int `json:"version"`
+	Added   int `json:"added"`
+	Updated int `json:"updated"`
+	Deleted int `json:"deleted"`
+	Kept    int `json:"kept"`
+}
+
+type KeepLocalScheme struct {
+	KeepLocal bool `json:"keep_local"`
+}
+
+// DeckVersion groups changes of a shared deck published together
+type DeckVersion struct {
+	Version     int                `json:"version"`
+	PublishedAt time.Time          `json:"published_at"`
+	Changes     []model.DeckChange `json:"changes"`
+}
+
+// DeckChangelog lists versions of a shared deck, oldest first
+type DeckChangelog struct {
+	Version  int           `json:"version"` // last published version
+	Versions []DeckVersion `json:"versions"`
+}
+
+// DeckMemberScheme is the role to give a member of a shared deck
+type DeckMemberScheme struct {
+	Role string `json:"role" binding:"required,oneof=viewer editor owner"`
+}
+
+// MoveDeckScheme nests a deck under another one, null makes it top-level
+type MoveDeckScheme struct {
+	ParentDeckId *uuid.UUID `json:"parent_deck_id"`
+}
+
+// UpdateDeckScheme changes only fields present in the body
+type UpdateDeckScheme struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=100"`
+	Description *string `json:"description" binding:"omitempty,max=100"`
+	IsPublic    *bool   `json:"is_public"`
+}
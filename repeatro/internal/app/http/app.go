@@ -53,7 +53,7 @@ func New(
 	router := gin.Default()
 	router.Use(gin.Recovery(), cors.New(cors.Config{
 		AllowOrigins:     []string{"*"}, // adjust for your frontend
-		AllowMethods:     []string{"POST", "GET", "OPTIONS", "DELETE"},
+		AllowMethods:     []string{"POST", "GET", "PUT", "OPTIONS", "DELETE"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
@@ -78,7 +78,19 @@ func New(
 	cards.Handle(http.MethodGet, "/search", ctrl.SearchPublicCards)
 	cards.Handle(http.MethodPut, "/:id", ctrl.UpdateCard)
 	cards.Handle(http.MethodDelete, "/:id", ctrl.DeleteCard)
+	cards.Handle(http.MethodPost, "/:id/restore", ctrl.RestoreCard)
+	cards.Handle(http.MethodGet, "/:id/history", ctrl.ReadCardHistory)
+	cards.Handle(http.MethodPost, "/:id/revert/:rev", ctrl.RevertCard)
+	cards.Handle(http.MethodPost, "/:id/tags", ctrl.AddCardTags)
+	cards.Handle(http.MethodPut, "/:id/keep-local", ctrl.SetKeepLocal)
+	cards.Handle(http.MethodDelete, "/:id/tags/:tag", ctrl.RemoveCardTag)
+	cards.Handle(http.MethodPost, "/retag", ctrl.RetagCards)
+	cards.Handle(http.MethodPost, "/move", ctrl.MoveCards)
 	cards.Handle(http.MethodPost, "/answers", ctrl.AddAnswers)
+	cards.Handle(http.MethodPost, "/answers/undo", ctrl.UndoLastAnswer)
+	cards.Handle(http.MethodPost, "/sync", ctrl.SyncAnswers)
+	cards.Handle(http.MethodGet, "/preferences", ctrl.ReadPreferences)
+	cards.Handle(http.MethodPut, "/preferences", ctrl.UpdatePreferences)
 
 	decks := router.Group("/decks")
 	decks.Use(security.AuthMiddleware())
@@ -86,11 +98,41 @@ func New(
 	decks.Handle(http.MethodPost, "", ctrl.AddDeck)
 	decks.Handle(http.MethodGet, "", ctrl.ReadAllDecks)
 	decks.Handle(http.MethodGet, "/search", ctrl.SearchPublicDecks)
+	decks.Handle(http.MethodPost, "/import", ctrl.ImportDeck)
 	decks.Handle(http.MethodGet, "/:id", ctrl.ReadDeck)
+	decks.Handle(http.MethodPut, "/:id", ctrl.UpdateDeck)
 	decks.Handle(http.MethodDelete, "/:id", ctrl.DeleteDeck)
-	// decks.Handle(http.MethodPut, "/:id", ctrl.UpdateCard)
-	decks.Handle(http.MethodPost, "/:deck_id/cards/:card_id", ctrl.AddCardToDeck)
+	decks.Handle(http.MethodPost, "/:id/restore", ctrl.RestoreDeck)
+	decks.Handle(http.MethodGet, "/:id/tree", ctrl.ReadDeckTree)
+	decks.Handle(http.MethodPut, "/:id/parent", ctrl.MoveDeck)
+	decks.Handle(http.MethodGet, "/:id/due", ctrl.ReadDeckDueCounts)
+	decks.Handle(http.MethodPost, "/:id/clone", ctrl.CloneDeck)
+	decks.Handle(http.MethodPost, "/:id/subscription", ctrl.SubscribeDeck)
+	decks.Handle(http.MethodDelete, "/:id/subscription", ctrl.UnsubscribeDeck)
+	decks.Handle(http.MethodPost, "/:id/sync", ctrl.SyncDeck)
+	decks.Handle(http.MethodPost, "/:id/publish", ctrl.PublishDeck)
+	decks.Handle(http.MethodGet, "/:id/changelog", ctrl.ReadDeckChangelog)
+	decks.Handle(http.MethodGet, "/:id/members", ctrl.ReadDeckMembers)
+	decks.Handle(http.MethodPut, "/:id/members/:user_id", ctrl.InviteDeckMember)
+	decks.Handle(http.MethodDelete, "/:id/members/:user_id", ctrl.RemoveDeckMember)
+	decks.Handle(http.MethodPost, "/:id/cards/:card_id", ctrl.AddCardToDeck)
+	decks.Handle(http.MethodDelete, "/:id/cards/:card_id", ctrl.RemoveCardFromDeck)
 	decks.Handle(http.MethodGet, "/:id/cards", ctrl.ReadCardsFromDeck)
+	decks.Handle(http.MethodPost, "/:id/import", ctrl.ImportCards)
+	decks.Handle(http.MethodGet, "/:id/export", ctrl.ExportDeck)
+	decks.Handle(http.MethodGet, "/:id/options", ctrl.ReadDeckOptions)
+	decks.Handle(http.MethodPut, "/:id/options", ctrl.UpdateDeckOptions)
+
+	tags := router.Group("/tags")
+	tags.Use(security.AuthMiddleware())
+
+	tags.Handle(http.MethodGet, "", ctrl.ReadTags)
+	tags.Handle(http.MethodPut, "/:tag", ctrl.RenameTag)
+
+	trash := router.Group("/trash")
+	trash.Use(security.AuthMiddleware())
+
+	trash.Handle(http.MethodGet, "", ctrl.ReadTrash)
 
 	stats := router.Group("/stats")
 	stats.Use(security.AuthMiddleware())
@@ -2,7 +2,9 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"time"
 
@@ -117,12 +119,17 @@ func (c *Client) ReadAllCardsToLearn(ctx context.Context, uid uuid.UUID) ([]mode
 	return cards, nil
 }
 
-func (c *Client) ReadAllCards(ctx context.Context, uid uuid.UUID) ([]modelCard.Card, error) {
-	const op = "grpc.ReadAllCards"
+func (c *Client) ReadStudyQueue(ctx context.Context, did uuid.UUID, tag string) ([]modelCard.Card, error) {
+	const op = "grpc.ReadStudyQueue"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.ReadAllOwnCards(ctx, &emptypb.Empty{})
+	req := &cardv1.ReadStudyQueueRequest{Tag: tag}
+	if did != uuid.Nil {
+		req.DeckId = did.String()
+	}
+
+	resp, err := c.api.ReadStudyQueue(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -137,45 +144,80 @@ func (c *Client) ReadAllCards(ctx context.Context, uid uuid.UUID) ([]modelCard.C
 	return cards, nil
 }
 
-func (c *Client) SearchAllPublicCards(ctx context.Context) ([]modelCard.Card, error) {
+// ReadAllCards returns a page of the user's cards, only with the tag if it is
+// set, and the cursor of the next page
+func (c *Client) ReadAllCards(ctx context.Context, uid uuid.UUID, tag string, cursor string, limit int) ([]modelCard.Card, string, error) {
+	const op = "grpc.ReadAllCards"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadAllOwnCards(ctx, &cardv1.ReadAllOwnCardsRequest{
+		Cursor: cursor,
+		Limit:  int32(limit),
+		Tag:    tag,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	cards, err := toModelCards(resp.Cards)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	return cards, resp.NextCursor, nil
+}
+
+// SearchAllPublicCards returns a page of public cards, only those matching a non-empty query
+func (c *Client) SearchAllPublicCards(ctx context.Context, query string, cursor string, limit int) ([]modelCard.Card, string, error) {
 	const op = "grpc.SearchAllPublicCards"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.SearchAllPublicCards(ctx, &emptypb.Empty{})
+	resp, err := c.api.SearchAllPublicCards(ctx, &cardv1.SearchPublicCardsRequest{
+		Query:  query,
+		Cursor: cursor,
+		Limit:  int32(limit),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-	cards := make([]modelCard.Card, 0, len(resp.Cards))
-	for _, protoCard := range resp.Cards {
-		card, err := convert.FromProtoToModelCard(protoCard)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
-		}
-		cards = append(cards, *card)
+	cards, err := toModelCards(resp.Cards)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-	return cards, nil
+	return cards, resp.NextCursor, nil
 }
 
-func (c *Client) SearchUserPublicCards(ctx context.Context, uid uuid.UUID) ([]modelCard.Card, error) {
+func (c *Client) SearchUserPublicCards(ctx context.Context, uid uuid.UUID, query string, cursor string, limit int) ([]modelCard.Card, string, error) {
 	const op = "grpc.SearchUserPublicCards"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.SearchUserPublicCards(ctx, &cardv1.SearchUserPublicCardsRequest{UserId: uid.String()})
+	resp, err := c.api.SearchUserPublicCards(ctx, &cardv1.SearchUserPublicCardsRequest{
+		UserId: uid.String(),
+		Query:  query,
+		Cursor: cursor,
+		Limit:  int32(limit),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-	cards := make([]modelCard.Card, 0, len(resp.Cards))
-	for _, protoCard := range resp.Cards {
+	cards, err := toModelCards(resp.Cards)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	return cards, resp.NextCursor, nil
+}
+
+func toModelCards(protoCards []*cardv1.Card) ([]modelCard.Card, error) {
+	cards := make([]modelCard.Card, 0, len(protoCards))
+	for _, protoCard := range protoCards {
 		card, err := convert.FromProtoToModelCard(protoCard)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, err
 		}
 		cards = append(cards, *card)
 	}
 	return cards, nil
-	// return nil, nil
 }
 
 func (c *Client) UpdateCard(ctx context.Context, uid uuid.UUID, cid uuid.UUID, card *schemes.UpdateCardScheme) (modelCard.Card, error) {
@@ -219,7 +261,84 @@ func (c *Client) DeleteCard(ctx context.Context, cid uuid.UUID, uid uuid.UUID) (
 	return resp.Success, nil
 }
 
-func (c *Client) AddAnswers(ctx context.Context, uid uuid.UUID, answers []*schemes.AnswerScheme) (string, error) {
+func (c *Client) ReadTrashedCards(ctx context.Context) ([]modelCard.Card, error) {
+	const op = "grpc.ReadTrashedCards"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadTrashedCards(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cards := make([]modelCard.Card, 0, len(resp.Cards))
+	for _, protoCard := range resp.Cards {
+		card, err := convert.FromProtoToModelCard(protoCard)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		cards = append(cards, *card)
+	}
+	return cards, nil
+}
+
+func (c *Client) RestoreCard(ctx context.Context, cid uuid.UUID) (modelCard.Card, error) {
+	const op = "grpc.RestoreCard"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.RestoreCard(ctx, &cardv1.RestoreCardRequest{CardId: cid.String()})
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := convert.FromProtoToModelCard(resp.Card)
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *card, nil
+}
+
+func (c *Client) ReadCardHistory(ctx context.Context, cid uuid.UUID) ([]modelCard.CardRevision, error) {
+	const op = "grpc.ReadCardHistory"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadCardHistory(ctx, &cardv1.ReadCardHistoryRequest{CardId: cid.String()})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revisions := make([]modelCard.CardRevision, 0, len(resp.Revisions))
+	for _, protoRevision := range resp.Revisions {
+		revision, err := convert.FromProtoToModelCardRevision(protoRevision)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		revisions = append(revisions, *revision)
+	}
+	return revisions, nil
+}
+
+func (c *Client) RevertCard(ctx context.Context, cid uuid.UUID, revision int) (modelCard.Card, error) {
+	const op = "grpc.RevertCard"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.RevertCard(ctx, &cardv1.RevertCardRequest{
+		CardId:   cid.String(),
+		Revision: int32(revision),
+	})
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := convert.FromProtoToModelCard(resp.Card)
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *card, nil
+}
+
+func (c *Client) AddAnswers(ctx context.Context, uid uuid.UUID, answers []*schemes.AnswerScheme) ([]schemes.AnswerResult, error) {
 	const op = "grpc.AddAnswers"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
@@ -227,14 +346,279 @@ func (c *Client) AddAnswers(ctx context.Context, uid uuid.UUID, answers []*schem
 	convertedAnswers, err := convert.FromAnswerSchemesToProtosCard(answers)
 	if err != nil {
 		fmt.Printf("%s: %v", op, err)
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	resp, err := c.api.AddAnswers(ctx, &cardv1.AddAnswersRequest{
 		Answers: convertedAnswers,
 	})
 	if err != nil {
 		fmt.Printf("%s: %v", op, err)
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	results, err := convert.FromProtoToAnswerResults(resp.Results)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return results, nil
+}
+
+func (c *Client) SyncAnswers(ctx context.Context, answers []*schemes.AnswerScheme) (*schemes.SyncResult, error) {
+	const op = "grpc.SyncAnswers"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	convertedAnswers, err := convert.FromAnswerSchemesToProtosCard(answers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	resp, err := c.api.SyncAnswers(ctx, &cardv1.SyncAnswersRequest{
+		Answers: convertedAnswers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := &schemes.SyncResult{}
+	for _, protoCard := range resp.Cards {
+		card, err := convert.FromProtoToModelCard(protoCard)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result.Cards = append(result.Cards, *card)
+	}
+	if result.Results, err = convert.FromProtoToAnswerResults(resp.Results); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if result.Conflicts, err = convert.FromProtoToSyncConflicts(resp.Conflicts); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return result, nil
+}
+
+func (c *Client) UndoLastAnswer(ctx context.Context) (modelCard.Card, error) {
+	const op = "grpc.UndoLastAnswer"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.UndoLastAnswer(ctx, &emptypb.Empty{})
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := convert.FromProtoToModelCard(resp.Card)
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *card, nil
+}
+
+func (c *Client) ReadPreferences(ctx context.Context) (*modelCard.Preference, error) {
+	const op = "grpc.ReadPreferences"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadPreferences(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	preference, err := convert.FromProtoToModelPreferences(resp.Preferences)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return preference, nil
+}
+
+func (c *Client) UpdatePreferences(ctx context.Context, algorithm string) (*modelCard.Preference, error) {
+	const op = "grpc.UpdatePreferences"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.UpdatePreferences(ctx, &cardv1.UpdatePreferencesRequest{
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	preference, err := convert.FromProtoToModelPreferences(resp.Preferences)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return preference, nil
+}
+
+// RetagCards adds and removes tags on the cards and returns them as updated
+func (c *Client) RetagCards(ctx context.Context, cids []uuid.UUID, add []string, remove []string) ([]modelCard.Card, error) {
+	const op = "grpc.RetagCards"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	cardIds := make([]string, 0, len(cids))
+	for _, cid := range cids {
+		cardIds = append(cardIds, cid.String())
+	}
+
+	resp, err := c.api.RetagCards(ctx, &cardv1.RetagCardsRequest{
+		CardIds: cardIds,
+		Add:     add,
+		Remove:  remove,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	cards, err := toModelCards(resp.Cards)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cards, nil
+}
+
+func (c *Client) ReadTags(ctx context.Context) ([]modelCard.TagCount, error) {
+	const op = "grpc.ReadTags"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadTags(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return convert.FromProtoToModelTagCounts(resp.Tags), nil
+}
+
+// RenameTag renames the tag on all cards of the user and returns how many cards changed
+func (c *Client) RenameTag(ctx context.Context, tag string, newName string) (int64, error) {
+	const op = "grpc.RenameTag"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.RenameTag(ctx, &cardv1.RenameTagRequest{
+		Tag:     tag,
+		NewName: newName,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return resp.CardsCount, nil
+}
+
+// ImportCards streams rows pulled from next to the deck until next returns io.EOF.
+// An error of next aborts the import
+func (c *Client) ImportCards(ctx context.Context, did uuid.UUID, next func() ([]schemes.ImportRow, error)) (*schemes.ImportResult, error) {
+	const op = "grpc.ImportCards"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.api.ImportCards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := stream.Send(&cardv1.ImportCardsRequest{DeckId: did.String()}); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for {
+		rows, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		// io.EOF means the server ended the import, its error comes with the response
+		if err := stream.Send(&cardv1.ImportCardsRequest{Rows: convert.FromImportRowsToProto(rows)}); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return convert.FromProtoToImportResult(resp), nil
+}
+
+// ReadDeckReviews returns the user's answers to cards of the deck, oldest first
+func (c *Client) ReadDeckReviews(ctx context.Context, did uuid.UUID) ([]schemes.Review, error) {
+	const op = "grpc.ReadDeckReviews"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadDeckReviews(ctx, &cardv1.ReadDeckReviewsRequest{DeckId: did.String()})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	reviews, err := convert.FromProtoToReviews(resp.Reviews)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return reviews, nil
+}
+
+// PublishDeck records changes of cards in the deck as a new version
+func (c *Client) PublishDeck(ctx context.Context, did uuid.UUID) (*schemes.PublishResult, error) {
+	const op = "grpc.PublishDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.PublishDeck(ctx, &cardv1.PublishDeckRequest{DeckId: did.String()})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return convert.FromProtoToPublishResult(resp), nil
+}
+
+// ReadDeckChanges returns changes of the deck published after the version and its last version
+func (c *Client) ReadDeckChanges(ctx context.Context, did uuid.UUID, sinceVersion int) ([]modelCard.DeckChange, int, error) {
+	const op = "grpc.ReadDeckChanges"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadDeckChanges(ctx, &cardv1.ReadDeckChangesRequest{
+		DeckId:       did.String(),
+		SinceVersion: int32(sinceVersion),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	changes, err := convert.FromProtoToDeckChanges(did, resp.Changes)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return changes, int(resp.Version), nil
+}
+
+// SyncDeck merges updates of the source deck into the subscribed copy
+func (c *Client) SyncDeck(ctx context.Context, did uuid.UUID) (*schemes.DeckSyncResult, error) {
+	const op = "grpc.SyncDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.SyncDeck(ctx, &cardv1.SyncDeckRequest{DeckId: did.String()})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return convert.FromProtoToDeckSyncResult(resp), nil
+}
+
+func (c *Client) SetKeepLocal(ctx context.Context, cid uuid.UUID, keepLocal bool) (modelCard.Card, error) {
+	const op = "grpc.SetKeepLocal"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.SetKeepLocal(ctx, &cardv1.SetKeepLocalRequest{
+		CardId:    cid.String(),
+		KeepLocal: keepLocal,
+	})
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
+	}
+	card, err := convert.FromProtoToModelCard(resp.Card)
+	if err != nil {
+		return modelCard.Card{}, fmt.Errorf("%s: %w", op, err)
 	}
-	return resp.Message, nil
+	return *card, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/GOeda-Co/proto-contract/convert"
 	modelCard "github.com/GOeda-Co/proto-contract/model/card"
 	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 	"github.com/google/uuid"
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
@@ -91,10 +92,15 @@ func (c *Client) AddDeck(ctx context.Context, deck *modelDeck.Deck) (modelDeck.D
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.AddDeck(ctx, &deckv1.AddDeckRequest{
+	request := &deckv1.AddDeckRequest{
 		Name:        deck.Name,
 		Description: deck.Description,
-	})
+		IsPublic:    deck.IsPublic,
+	}
+	if deck.ParentDeckId != nil {
+		request.ParentDeckId = deck.ParentDeckId.String()
+	}
+	resp, err := c.api.AddDeck(ctx, request)
 	if err != nil {
 		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -106,25 +112,22 @@ func (c *Client) AddDeck(ctx context.Context, deck *modelDeck.Deck) (modelDeck.D
 	return *deckModel, nil
 }
 
-func (c *Client) ReadAllDecks(ctx context.Context) ([]modelDeck.Deck, error) {
+// ReadAllDecks returns a page of the user's decks and the cursor of the next page
+func (c *Client) ReadAllDecks(ctx context.Context, cursor string, limit int) ([]modelDeck.Deck, string, error) {
 	const op = "grpc.ReadAllDecks"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.ReadAllDecks(ctx, &emptypb.Empty{})
+	resp, err := c.api.ReadAllDecks(ctx, &cardv1.PageRequest{Cursor: cursor, Limit: int32(limit)})
 	if err != nil {
 		fmt.Printf("%s: %s", op, err.Error())
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-	decks := make([]modelDeck.Deck, 0, len(resp.Decks))
-	for _, protoDeck := range resp.Decks {
-		deck, err := convert.FromProtoToModelDeck(protoDeck)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
-		}
-		decks = append(decks, *deck)
+	decks, err := toModelDecks(resp.Decks)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-	return decks, nil
+	return decks, resp.NextCursor, nil
 }
 
 func (c *Client) ReadDeck(ctx context.Context, did uuid.UUID) (modelDeck.Deck, error) {
@@ -145,34 +148,176 @@ func (c *Client) ReadDeck(ctx context.Context, did uuid.UUID) (modelDeck.Deck, e
 	return *deckModel, nil
 }
 
-func (c *Client) SearchAllPublicDecks(ctx context.Context) ([]modelDeck.Deck, error) {
-	const op = "grpc.SearchAllPublicDecks"
+// UpdateDeck changes only fields set in the update
+func (c *Client) UpdateDeck(ctx context.Context, did uuid.UUID, update *schemes.UpdateDeckScheme) (modelDeck.Deck, error) {
+	const op = "grpc.UpdateDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.UpdateDeck(ctx, &deckv1.UpdateDeckRequest{
+		DeckId:      did.String(),
+		Name:        update.Name,
+		Description: update.Description,
+		IsPublic:    update.IsPublic,
+	})
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	deckModel, err := convert.FromProtoToModelDeck(resp.Deck)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *deckModel, nil
+}
+
+// ReadDeckTree returns the deck with all of its subdecks
+func (c *Client) ReadDeckTree(ctx context.Context, did uuid.UUID) ([]modelDeck.Deck, error) {
+	const op = "grpc.ReadDeckTree"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.SearchAllPublicDecks(ctx, &emptypb.Empty{})
+	resp, err := c.api.ReadDeckTree(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+
 	decks := make([]modelDeck.Deck, 0, len(resp.Decks))
-	for _, protoDeck := range resp.Decks {
-		deck, err := convert.FromProtoToModelDeck(protoDeck)
+	for _, deck := range resp.Decks {
+		deckModel, err := convert.FromProtoToModelDeck(deck)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-		decks = append(decks, *deck)
+		decks = append(decks, *deckModel)
 	}
 	return decks, nil
 }
 
-func (c *Client) SearchUserPublicDecks(ctx context.Context, uid string) ([]modelDeck.Deck, error) {
+// MoveDeck nests the deck under the parent deck, uuid.Nil makes it top-level
+func (c *Client) MoveDeck(ctx context.Context, did, parentId uuid.UUID) (modelDeck.Deck, error) {
+	const op = "grpc.MoveDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	request := &deckv1.MoveDeckRequest{DeckId: did.String()}
+	if parentId != uuid.Nil {
+		request.ParentDeckId = parentId.String()
+	}
+	resp, err := c.api.MoveDeck(ctx, request)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	deckModel, err := convert.FromProtoToModelDeck(resp.Deck)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *deckModel, nil
+}
+
+func (c *Client) ReadDeckDueCounts(ctx context.Context, did uuid.UUID) ([]modelDeck.DueCount, error) {
+	const op = "grpc.ReadDeckDueCounts"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadDeckDueCounts(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	counts := make([]modelDeck.DueCount, 0, len(resp.Counts))
+	for _, count := range resp.Counts {
+		countModel, err := convert.FromProtoToModelDueCount(count)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		counts = append(counts, *countModel)
+	}
+	return counts, nil
+}
+
+// SearchAllPublicDecks returns a page of public decks, only those matching a non-empty query
+func (c *Client) SearchAllPublicDecks(ctx context.Context, query string, cursor string, limit int) ([]modelDeck.Deck, string, error) {
+	const op = "grpc.SearchAllPublicDecks"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.SearchAllPublicDecks(ctx, &deckv1.SearchPublicDecksRequest{
+		Query:  query,
+		Cursor: cursor,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	decks, err := toModelDecks(resp.Decks)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	return decks, resp.NextCursor, nil
+}
+
+func (c *Client) SearchUserPublicDecks(ctx context.Context, uid string, query string, cursor string, limit int) ([]modelDeck.Deck, string, error) {
 	const op = "grpc.SearchUserPublicDecks"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
 	resp, err := c.api.SearchUserPublicDecks(ctx, &deckv1.SearchUserPublicDecksRequest{
 		UserId: uid,
+		Query:  query,
+		Cursor: cursor,
+		Limit:  int32(limit),
 	})
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	decks, err := toModelDecks(resp.Decks)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	return decks, resp.NextCursor, nil
+}
+
+func toModelDecks(protoDecks []*deckv1.Deck) ([]modelDeck.Deck, error) {
+	decks := make([]modelDeck.Deck, 0, len(protoDecks))
+	for _, protoDeck := range protoDecks {
+		deck, err := convert.FromProtoToModelDeck(protoDeck)
+		if err != nil {
+			return nil, err
+		}
+		decks = append(decks, *deck)
+	}
+	return decks, nil
+}
+
+func (c *Client) DeleteDeck(ctx context.Context, did uuid.UUID, mode string, targetId uuid.UUID) error {
+	const op = "grpc.DeleteDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	req := &deckv1.DeleteDeckRequest{
+		DeckId: did.String(),
+		Mode:   mode,
+	}
+	if targetId != uuid.Nil {
+		req.TargetDeckId = targetId.String()
+	}
+
+	_, err := c.api.DeleteDeck(ctx, req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (c *Client) ReadTrashedDecks(ctx context.Context) ([]modelDeck.Deck, error) {
+	const op = "grpc.ReadTrashedDecks"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadTrashedDecks(ctx, &emptypb.Empty{})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -187,13 +332,131 @@ func (c *Client) SearchUserPublicDecks(ctx context.Context, uid string) ([]model
 	return decks, nil
 }
 
-func (c *Client) DeleteDeck(ctx context.Context, did uuid.UUID) error {
-	const op = "grpc.DeleteDeck"
+func (c *Client) RestoreDeck(ctx context.Context, did uuid.UUID) (modelDeck.Deck, error) {
+	const op = "grpc.RestoreDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.RestoreDeck(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	deckModel, err := convert.FromProtoToModelDeck(resp.Deck)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *deckModel, nil
+}
+
+// CloneDeck copies a public or own deck with its cards to the user
+func (c *Client) CloneDeck(ctx context.Context, did uuid.UUID) (modelDeck.Deck, error) {
+	const op = "grpc.CloneDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.CloneDeck(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	deckModel, err := convert.FromProtoToModelDeck(resp.Deck)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *deckModel, nil
+}
+
+// SubscribeDeck clones a public deck as a copy following its published updates
+func (c *Client) SubscribeDeck(ctx context.Context, did uuid.UUID) (modelDeck.Deck, error) {
+	const op = "grpc.SubscribeDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.SubscribeDeck(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	deckModel, err := convert.FromProtoToModelDeck(resp.Deck)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *deckModel, nil
+}
+
+func (c *Client) UnsubscribeDeck(ctx context.Context, did uuid.UUID) (modelDeck.Deck, error) {
+	const op = "grpc.UnsubscribeDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.UnsubscribeDeck(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	deckModel, err := convert.FromProtoToModelDeck(resp.Deck)
+	if err != nil {
+		return modelDeck.Deck{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *deckModel, nil
+}
+
+func (c *Client) ReadDeckMembers(ctx context.Context, did uuid.UUID) ([]modelDeck.Member, error) {
+	const op = "grpc.ReadDeckMembers"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadDeckMembers(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members := make([]modelDeck.Member, 0, len(resp.Members))
+	for _, member := range resp.Members {
+		memberModel, err := convert.FromProtoToModelDeckMember(member)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		members = append(members, *memberModel)
+	}
+	return members, nil
+}
+
+func (c *Client) InviteDeckMember(ctx context.Context, did, uid uuid.UUID, role string) (modelDeck.Member, error) {
+	const op = "grpc.InviteDeckMember"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.InviteDeckMember(ctx, &deckv1.InviteDeckMemberRequest{
+		DeckId: did.String(),
+		UserId: uid.String(),
+		Role:   role,
+	})
+	if err != nil {
+		return modelDeck.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+	memberModel, err := convert.FromProtoToModelDeckMember(resp.Member)
+	if err != nil {
+		return modelDeck.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *memberModel, nil
+}
+
+func (c *Client) RemoveDeckMember(ctx context.Context, did, uid uuid.UUID) error {
+	const op = "grpc.RemoveDeckMember"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	_, err := c.api.DeleteDeck(ctx, &deckv1.ReadDeckRequest{
+	_, err := c.api.RemoveDeckMember(ctx, &deckv1.RemoveDeckMemberRequest{
 		DeckId: did.String(),
+		UserId: uid.String(),
 	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -216,16 +479,52 @@ func (c *Client) AddCardToDeck(ctx context.Context, did, cid uuid.UUID) error {
 	return nil
 }
 
-func (c *Client) ReadCardsFromDeck(ctx context.Context, did uuid.UUID) ([]modelCard.Card, error) {
+func (c *Client) RemoveCardFromDeck(ctx context.Context, did, cid uuid.UUID) error {
+	const op = "grpc.RemoveCardFromDeck"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	_, err := c.api.RemoveCardFromDeck(ctx, &deckv1.RemoveCardFromDeckRequest{
+		DeckId: did.String(),
+		CardId: cid.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (c *Client) MoveCards(ctx context.Context, cardIds []uuid.UUID, did uuid.UUID) (int, error) {
+	const op = "grpc.MoveCards"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	ids := make([]string, 0, len(cardIds))
+	for _, id := range cardIds {
+		ids = append(ids, id.String())
+	}
+	resp, err := c.api.MoveCards(ctx, &deckv1.MoveCardsRequest{
+		CardIds: ids,
+		DeckId:  did.String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(resp.Moved), nil
+}
+
+func (c *Client) ReadCardsFromDeck(ctx context.Context, did uuid.UUID, cursor string, limit int) ([]modelCard.Card, string, error) {
 	const op = "grpc.ReadCardsFromDeck"
 
 	ctx = withToken(ctx, ctx.Value("token").(string))
 
-	resp, err := c.api.ReadCardsFromDeck(ctx, &deckv1.ReadDeckRequest{
+	resp, err := c.api.ReadCardsFromDeck(ctx, &deckv1.ReadCardsFromDeckRequest{
 		DeckId: did.String(),
+		Cursor: cursor,
+		Limit:  int32(limit),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 	cards := make([]modelCard.Card, 0, len(resp.Cards))
 	for _, protoCard := range resp.Cards {
@@ -242,9 +541,45 @@ func (c *Client) ReadCardsFromDeck(ctx context.Context, did uuid.UUID) ([]modelC
 			CreatedAt:        timestamppb.New(protoCard.CreatedAt.AsTime()),
 			UpdatedAt:        timestamppb.New(protoCard.UpdatedAt.AsTime())})
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, "", fmt.Errorf("%s: %w", op, err)
 		}
 		cards = append(cards, *card)
 	}
-	return cards, nil
+	return cards, resp.NextCursor, nil
+}
+
+func (c *Client) ReadDeckOptions(ctx context.Context, did uuid.UUID) (modelDeck.Options, error) {
+	const op = "grpc.ReadDeckOptions"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.ReadDeckOptions(ctx, &deckv1.ReadDeckRequest{
+		DeckId: did.String(),
+	})
+	if err != nil {
+		return modelDeck.Options{}, fmt.Errorf("%s: %w", op, err)
+	}
+	options, err := convert.FromProtoToModelDeckOptions(resp.Options)
+	if err != nil {
+		return modelDeck.Options{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *options, nil
+}
+
+func (c *Client) UpdateDeckOptions(ctx context.Context, options *modelDeck.Options) (modelDeck.Options, error) {
+	const op = "grpc.UpdateDeckOptions"
+
+	ctx = withToken(ctx, ctx.Value("token").(string))
+
+	resp, err := c.api.UpdateDeckOptions(ctx, &deckv1.UpdateDeckOptionsRequest{
+		Options: convert.FromModelToProtoDeckOptions(options),
+	})
+	if err != nil {
+		return modelDeck.Options{}, fmt.Errorf("%s: %w", op, err)
+	}
+	updated, err := convert.FromProtoToModelDeckOptions(resp.Options)
+	if err != nil {
+		return modelDeck.Options{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return *updated, nil
 }
@@ -3,8 +3,11 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/google/uuid"
 	_ "github.com/swaggo/swag/example/celler/httputil"
@@ -18,12 +21,17 @@ import (
 // AddCard godoc
 //
 //	@Summary		Add a card
-//	@Description	Add a new card for the authenticated user
+//	@Description	Add a new card for the authenticated user. template is forward (default), reverse, both or cloze;
+//	@Description	both adds a forward card with a reverse sibling returned in siblings, each scheduled on its own.
+//	@Description	A cloze card has cloze deletions in word ({{c1::answer}} or {{c1::answer::hint}}) and optional extra
+//	@Description	text in translation, one card per cloze index is added with the index in ordinal.
+//	@Description	Returned cards carry front and back, the text asked and shown after answering
 //	@Tags			cards
 //	@Accept			json
 //	@Produce		json
 //	@Param			card	body		model.Card	true	"Card to add"
 //	@Success		200		{object}	model.Card
+//	@Failure		400		{object}	map[string]string	"Invalid template or cloze markup"
 //	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to read request body, get user ID, or add card"
 //	@Router			/cards [post]
 func (cc *Controller) AddCard(ctx *gin.Context) {
@@ -44,7 +52,7 @@ func (cc *Controller) AddCard(ctx *gin.Context) {
 
 	response, err := cc.cardClient.AddCard(ctx, &card)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to add card: %v", err)})
+		abortWithCardError(ctx, err)
 		return
 	}
 
@@ -53,22 +61,30 @@ func (cc *Controller) AddCard(ctx *gin.Context) {
 
 // ReadAllCardsToLearn godoc
 //
-//	@Summary		Get all cards to learn
-//	@Description	Retrieves all cards assigned to the user for learning
+//	@Summary		Get today's study queue
+//	@Description	Retrieves due learning cards first, then reviews interleaved with new cards. New and review cards are limited by daily limits of their decks
 //	@Tags			cards
 //	@Produce		json
-//	@Success		200	{array}		model.Card
-//	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to get user ID or retrieve cards"
+//	@Param			deck_id	query		string	false	"Deck ID to study only one deck with its subdecks"
+//	@Param			tag		query		string	false	"Study only cards with the tag"
+//	@Success		200		{array}		model.Card
+//	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid deck ID format or tag"
+//	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to retrieve cards"
 //	@Router			/cards/learn [get]
 func (cc *Controller) ReadAllCardsToLearn(ctx *gin.Context) {
-	userId, err := GetUserIdFromContext(ctx)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to get user ID from context: %v", err)})
-		return
+	deckId := uuid.Nil
+	if deckIdParam := ctx.Query("deck_id"); deckIdParam != "" {
+		var err error
+		deckId, err = uuid.Parse(deckIdParam)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID format"})
+			return
+		}
 	}
-	response, err := cc.cardClient.ReadAllCardsToLearn(ctx, userId)
+
+	response, err := cc.cardClient.ReadStudyQueue(ctx, deckId, ctx.Query("tag"))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to retrieve cards: %v", err)})
+		abortWithCardError(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -81,7 +97,11 @@ func (cc *Controller) ReadAllCardsToLearn(ctx *gin.Context) {
 //	@Tags			cards
 //	@Produce		json
 //	@Param			user_id	query		string	false	"User ID (admin only)"
+//	@Param			tag		query		string	false	"Only cards with the tag"
+//	@Param			cursor	query		string	false	"Cursor returned in X-Next-Cursor of the previous page"
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
 //	@Success		200		{array}		model.Card
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page, absent on the last page"
 //	@Failure		403		{object}	model.ErrorResponse	"Forbidden - User without admin rights cannot access other users' cards"
 //	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid user_id format"
 //	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to get user ID or retrieve cards"
@@ -133,12 +153,19 @@ func (cc *Controller) ReadAllCards(ctx *gin.Context) {
 
 	cc.log.Debug("Final Target User ID", "targetUserId", targetUserId)
 
-	response, err := cc.cardClient.ReadAllCards(ctx, targetUserId)
+	cursor, limit, err := getPageFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, next, err := cc.cardClient.ReadAllCards(ctx, targetUserId, ctx.Query("tag"), cursor, limit)
 	if err != nil {
 		cc.log.Debug("Error calling card client", "error", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to retrieve cards: %v", err)})
+		abortWithCardError(ctx, err)
 		return
 	}
+	setNextCursor(ctx, next)
 
 	cc.log.Debug("Cards response", "length", len(response))
 	if len(response) > 0 {
@@ -148,27 +175,40 @@ func (cc *Controller) ReadAllCards(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
-// SearchUserPublicCards godoc
+// SearchPublicCards godoc
 //
-//	@Summary		Search user's public cards
-//	@Description	Retrieves all public cards created by a specific user
+//	@Summary		Search public cards
+//	@Description	Retrieves public cards, of a specific user if user_id is provided. With q only cards whose word, translation or tags match are returned, best matches first, with word_highlight and translation_highlight
 //	@Tags			cards
 //	@Produce		json
-//	@Param			user_id	query		string	true	"User ID"
+//	@Param			user_id	query		string	false	"User ID to filter by specific user's public cards"
+//	@Param			q		query		string	false	"Full-text query, results are ranked by relevance and highlighted"
+//	@Param			cursor	query		string	false	"Cursor returned in X-Next-Cursor of the previous page"
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
 //	@Success		200		{array}		model.Card
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page, absent on the last page"
 //	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid user ID format"
-//	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to search user's public cards"
-//	@Router			/cards/public [get]
+//	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to search public cards"
+//	@Router			/cards/search [get]
 func (cc *Controller) SearchPublicCards(ctx *gin.Context) {
+	cursor, limit, err := getPageFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	query := ctx.Query("q")
+
 	var uid string
 	var ok bool
 	uid, ok = ctx.GetQuery("user_id")
 	if !ok || uid == "" {
-		response, err := cc.cardClient.SearchAllPublicCards(ctx)
+		response, next, err := cc.cardClient.SearchAllPublicCards(ctx, query, cursor, limit)
 		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to search public cards: %v", err)})
+			abortWithCardError(ctx, err)
 			return
 		}
+		setNextCursor(ctx, next)
 		ctx.JSON(http.StatusOK, response)
 		return
 	}
@@ -178,11 +218,12 @@ func (cc *Controller) SearchPublicCards(ctx *gin.Context) {
 		return
 	}
 
-	response, err := cc.cardClient.SearchUserPublicCards(ctx, parsedUid)
+	response, next, err := cc.cardClient.SearchUserPublicCards(ctx, parsedUid, query, cursor, limit)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to search public cards: %v", err)})
+		abortWithCardError(ctx, err)
 		return
 	}
+	setNextCursor(ctx, next)
 	ctx.JSON(http.StatusOK, response)
 }
 
@@ -226,10 +267,213 @@ func (cc *Controller) UpdateCard(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, card)
 }
 
+// ReadCardHistory godoc
+//
+//	@Summary		Card edit history
+//	@Description	List revisions of a card, oldest first. Each revision has who and when edited the card, changed fields and content after the change
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		string	true	"Card ID"
+//	@Success		200	{array}		model.CardRevision
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/cards/{id}/history [get]
+func (cc *Controller) ReadCardHistory(ctx *gin.Context) {
+	cardId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	revisions, err := cc.cardClient.ReadCardHistory(ctx, cardId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
+// RevertCard godoc
+//
+//	@Summary		Revert a card
+//	@Description	Restore word, translation, tags and visibility of a card as they were at the revision. Scheduling is kept, the revert is recorded as a new revision
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		string	true	"Card ID"
+//	@Param			rev	path		int		true	"Revision number"
+//	@Success		200	{object}	model.Card
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/cards/{id}/revert/{rev} [post]
+func (cc *Controller) RevertCard(ctx *gin.Context) {
+	cardId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	revision, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil || revision < 1 {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "revision must be a positive number"})
+		return
+	}
+
+	card, err := cc.cardClient.RevertCard(ctx, cardId, revision)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, card)
+}
+
+// AddCardTags godoc
+//
+//	@Summary		Tag a card
+//	@Description	Add tags to a card. Tags are lowercased single words, the change is recorded in card history
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Card ID"
+//	@Param			tags	body		schemes.TagsScheme	true	"Tags to add"
+//	@Success		200		{object}	model.Card
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string
+//	@Router			/cards/{id}/tags [post]
+func (cc *Controller) AddCardTags(ctx *gin.Context) {
+	cardId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var tags schemes.TagsScheme
+	if err := ctx.ShouldBindJSON(&tags); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cards, err := cc.cardClient.RetagCards(ctx, []uuid.UUID{cardId}, tags.Tags, nil)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cards[0])
+}
+
+// RemoveCardTag godoc
+//
+//	@Summary		Untag a card
+//	@Description	Remove a tag from a card, the change is recorded in card history
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		string	true	"Card ID"
+//	@Param			tag	path		string	true	"Tag"
+//	@Success		200	{object}	model.Card
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/cards/{id}/tags/{tag} [delete]
+func (cc *Controller) RemoveCardTag(ctx *gin.Context) {
+	cardId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cards, err := cc.cardClient.RetagCards(ctx, []uuid.UUID{cardId}, nil, []string{ctx.Param("tag")})
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cards[0])
+}
+
+// RetagCards godoc
+//
+//	@Summary		Retag cards
+//	@Description	Add and remove tags on several cards at once. All cards are changed or none, a tag both added and removed ends up removed
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			retag	body		schemes.RetagCardsScheme	true	"Cards and tags"
+//	@Success		200		{array}		model.Card
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string
+//	@Router			/cards/retag [post]
+func (cc *Controller) RetagCards(ctx *gin.Context) {
+	var retag schemes.RetagCardsScheme
+	if err := ctx.ShouldBindJSON(&retag); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cards, err := cc.cardClient.RetagCards(ctx, retag.CardIds, retag.Add, retag.Remove)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cards)
+}
+
+// MoveCards godoc
+//
+//	@Summary		Move cards to a deck
+//	@Description	Move several cards into a deck at once. All cards are moved or none, card counts of the decks
+//	@Description	they leave and of the target deck are kept in step. Cards take is_public of the target deck
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			move	body		schemes.MoveCardsScheme	true	"Cards and the target deck"
+//	@Success		200		{object}	map[string]int			"Number of cards moved"
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string
+//	@Router			/cards/move [post]
+func (cc *Controller) MoveCards(ctx *gin.Context) {
+	var move schemes.MoveCardsScheme
+	if err := ctx.ShouldBindJSON(&move); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	moved, err := cc.deckClient.MoveCards(ctx, move.CardIds, move.DeckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"moved": moved})
+}
+
+// abortWithCardError maps card service errors to HTTP statuses
+func abortWithCardError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case codes.NotFound:
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case codes.PermissionDenied:
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case codes.FailedPrecondition:
+		ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
 // DeleteCard godoc
 //
 //	@Summary		Delete a card
-//	@Description	Delete a card by ID
+//	@Description	Move a card to trash. It can be restored until it is purged after the retention period
 //	@Tags			cards
 //	@Param			id	path	string	true	"Card ID"
 //	@Success		200
@@ -256,17 +500,48 @@ func (cc *Controller) DeleteCard(ctx *gin.Context) {
 	ctx.Status(http.StatusOK)
 }
 
+// RestoreCard godoc
+//
+//	@Summary		Restore a card
+//	@Description	Take a card out of trash. Cards of a deck in trash are restored with the deck
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		string	true	"Card ID"
+//	@Success		200	{object}	model.Card
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Failure		409	{object}	map[string]string
+//	@Router			/cards/{id}/restore [post]
+func (cc *Controller) RestoreCard(ctx *gin.Context) {
+	cardId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	card, err := cc.cardClient.RestoreCard(ctx, cardId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, card)
+}
+
 // AddAnswers godoc
 //
 //	@Summary		Submit answers
-//	@Description	Submit answers to cards
+//	@Description	Submit answers to cards. Retried requests with the same Idempotency-Key header
+//	@Description	(or the same request_id on an answer) are not recorded twice, the original result is returned
 //	@Tags			answers
 //	@Accept			json
 //	@Produce		json
-//	@Param			answers	body		[]schemes.AnswerScheme	true	"List of answers"
-//	@Success		200		{object}	map[string]string
-//	@Failure		400		{object}	map[string]string
-//	@Failure		500		{object}	map[string]string
+//	@Param			Idempotency-Key	header		string					false	"Client request ID of the whole batch"
+//	@Param			answers			body		[]schemes.AnswerScheme	true	"List of answers"
+//	@Success		200				{object}	map[string]interface{}
+//	@Failure		400				{object}	map[string]string
+//	@Failure		500				{object}	map[string]string
 //	@Router			/answers [post]
 func (cc *Controller) AddAnswers(ctx *gin.Context) {
 	var answers []*schemes.AnswerScheme
@@ -283,11 +558,130 @@ func (cc *Controller) AddAnswers(ctx *gin.Context) {
 		return
 	}
 
-	fmt.Println("ANSSASAS", answers[0])
+	applyIdempotencyKey(ctx, answers)
 
-	if _, err = cc.cardClient.AddAnswers(ctx, userId, answers); err != nil {
+	results, err := cc.cardClient.AddAnswers(ctx, userId, answers)
+	if err != nil {
 		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
-	ctx.JSON(200, gin.H{"message": "added answers succesfully "})
+	ctx.JSON(200, gin.H{"message": "added answers succesfully ", "results": results})
+}
+
+// SyncAnswers godoc
+//
+//	@Summary		Sync offline answers
+//	@Description	Replays answers given offline in the order of answered_at, each scheduled at the time it was given.
+//	@Description	Answers to deleted cards or older than the last review on the server are dropped, answers to cards
+//	@Description	edited on the server afterwards are applied on top of the edit. Returns resulting card states
+//	@Tags			answers
+//	@Accept			json
+//	@Produce		json
+//	@Param			Idempotency-Key	header		string					false	"Client request ID of the whole batch"
+//	@Param			answers			body		[]schemes.AnswerScheme	true	"Answers with answered_at"
+//	@Success		200				{object}	schemes.SyncResult
+//	@Failure		400				{object}	map[string]string
+//	@Failure		500				{object}	map[string]string
+//	@Router			/cards/sync [post]
+func (cc *Controller) SyncAnswers(ctx *gin.Context) {
+	var answers []*schemes.AnswerScheme
+
+	if err := ctx.ShouldBindJSON(&answers); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	applyIdempotencyKey(ctx, answers)
+
+	result, err := cc.cardClient.SyncAnswers(ctx, answers)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// applyIdempotencyKey gives answers of a batch sent with Idempotency-Key header
+// keys derived from their position, so a retry of the same batch maps onto the same keys
+func applyIdempotencyKey(ctx *gin.Context, answers []*schemes.AnswerScheme) {
+	key := ctx.GetHeader("Idempotency-Key")
+	if key == "" {
+		return
+	}
+	for i, answer := range answers {
+		if answer.RequestId == "" {
+			answer.RequestId = fmt.Sprintf("%s#%d", key, i)
+		}
+	}
+}
+
+// UndoLastAnswer godoc
+//
+//	@Summary		Undo last answer
+//	@Description	Restores the card answered last to its state before the answer and removes the review from stats
+//	@Tags			answers
+//	@Produce		json
+//	@Success		200	{object}	model.Card
+//	@Failure		404	{object}	map[string]string	"Not Found - No answer to undo"
+//	@Failure		500	{object}	map[string]string
+//	@Router			/cards/answers/undo [post]
+func (cc *Controller) UndoLastAnswer(ctx *gin.Context) {
+	response, err := cc.cardClient.UndoLastAnswer(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "No answer to undo"})
+			return
+		}
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ReadPreferences godoc
+//
+//	@Summary		Get scheduling preferences
+//	@Description	Retrieves scheduling preferences of the authenticated user (algorithm used to reschedule answered cards)
+//	@Tags			cards
+//	@Produce		json
+//	@Success		200	{object}	model.Preference
+//	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to read preferences"
+//	@Router			/cards/preferences [get]
+func (cc *Controller) ReadPreferences(ctx *gin.Context) {
+	response, err := cc.cardClient.ReadPreferences(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to read preferences: %v", err)})
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdatePreferences godoc
+//
+//	@Summary		Update scheduling preferences
+//	@Description	Choose the scheduling algorithm ("sm2" or "fsrs") used for the authenticated user's reviews
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			preferences	body		schemes.UpdatePreferencesScheme	true	"Preferences to update"
+//	@Success		200			{object}	model.Preference
+//	@Failure		400			{object}	model.ErrorResponse	"Bad Request - Invalid body or unknown algorithm"
+//	@Router			/cards/preferences [put]
+func (cc *Controller) UpdatePreferences(ctx *gin.Context) {
+	var preferences schemes.UpdatePreferencesScheme
+	if err := ctx.ShouldBindJSON(&preferences); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := cc.cardClient.UpdatePreferences(ctx, preferences.Algorithm)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
 }
@@ -10,12 +10,13 @@ import (
 
 	// model "github.com/tomatoCoderq/repeatro/pkg/models"
 	model "github.com/GOeda-Co/proto-contract/model/deck"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
 )
 
 // AddDeck godoc
 //
 //	@Summary		Add a deck
-//	@Description	Create a new deck
+//	@Description	Create a new deck, nested under another deck of the user when parent_deck_id is set
 //	@Tags			decks
 //	@Accept			json
 //	@Produce		json
@@ -41,7 +42,7 @@ func (cc *Controller) AddDeck(ctx *gin.Context) {
 
 	response, err := cc.deckClient.AddDeck(ctx, &deck)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add deck"})
+		abortWithCardError(ctx, err)
 		return
 	}
 
@@ -54,15 +55,26 @@ func (cc *Controller) AddDeck(ctx *gin.Context) {
 //	@Description	Retrieves all decks in the system
 //	@Tags			decks
 //	@Produce		json
-//	@Success		200	{array}		model.Deck
-//	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to retrieve decks"
+//	@Param			cursor	query		string	false	"Cursor returned in X-Next-Cursor of the previous page"
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
+//	@Success		200		{array}		model.Deck
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page, absent on the last page"
+//	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid cursor or limit"
+//	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to retrieve decks"
 //	@Router			/decks [get]
 func (cc *Controller) ReadAllDecks(ctx *gin.Context) {
-	response, err := cc.deckClient.ReadAllDecks(ctx)
+	cursor, limit, err := getPageFromQuery(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, next, err := cc.deckClient.ReadAllDecks(ctx, cursor, limit)
+	if err != nil {
+		abortWithCardError(ctx, err)
 		return
 	}
+	setNextCursor(ctx, next)
 	ctx.JSON(http.StatusOK, response)
 }
 
@@ -93,18 +105,65 @@ func (cc *Controller) ReadDeck(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// UpdateDeck godoc
+//
+//	@Summary		Update a deck
+//	@Description	Change name, description or visibility of the deck. Fields left out of the body keep their values.
+//	@Description	Cards of the deck become public or private together with it. Only owners of the deck update it
+//	@Tags			decks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Deck ID"
+//	@Param			deck	body		schemes.UpdateDeckScheme	true	"Fields to change"
+//	@Success		200		{object}	model.Deck
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string
+//	@Router			/decks/{id} [put]
+func (cc *Controller) UpdateDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+	var update schemes.UpdateDeckScheme
+	if err := ctx.ShouldBindJSON(&update); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deck, err := cc.deckClient.UpdateDeck(ctx, deckId, &update)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deck)
+}
+
 // SearchPublicDecks godoc
 //
 //	@Summary		Search public decks
-//	@Description	Retrieves public decks. If user_id query parameter is provided, returns that user's public decks. Otherwise returns all public decks in the system.
+//	@Description	Retrieves public decks. If user_id query parameter is provided, returns that user's public decks. Otherwise returns all public decks in the system. With q only decks whose name or description match are returned, best matches first, with name_highlight and description_highlight
 //	@Tags			decks
 //	@Produce		json
 //	@Param			user_id	query		string	false	"User ID to filter by specific user's public decks"
+//	@Param			q		query		string	false	"Full-text query, results are ranked by relevance and highlighted"
+//	@Param			cursor	query		string	false	"Cursor returned in X-Next-Cursor of the previous page"
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
 //	@Success		200		{array}		model.Deck
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page, absent on the last page"
 //	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid user ID format"
 //	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to get user ID or search public decks"
 //	@Router			/decks/search [get]
 func (cc *Controller) SearchPublicDecks(ctx *gin.Context) {
+	cursor, limit, err := getPageFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	query := ctx.Query("q")
 	userIdParam := ctx.Query("user_id")
 
 	if userIdParam != "" {
@@ -114,19 +173,21 @@ func (cc *Controller) SearchPublicDecks(ctx *gin.Context) {
 			return
 		}
 
-		response, err := cc.deckClient.SearchUserPublicDecks(ctx, userIdParam)
+		response, next, err := cc.deckClient.SearchUserPublicDecks(ctx, userIdParam, query, cursor, limit)
 		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search user public decks"})
+			abortWithCardError(ctx, err)
 			return
 		}
+		setNextCursor(ctx, next)
 		ctx.JSON(http.StatusOK, response)
 	} else {
 		// Search all public decks
-		response, err := cc.deckClient.SearchAllPublicDecks(ctx)
+		response, next, err := cc.deckClient.SearchAllPublicDecks(ctx, query, cursor, limit)
 		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search public decks"})
+			abortWithCardError(ctx, err)
 			return
 		}
+		setNextCursor(ctx, next)
 		ctx.JSON(http.StatusOK, response)
 	}
 }
@@ -134,13 +195,17 @@ func (cc *Controller) SearchPublicDecks(ctx *gin.Context) {
 // DeleteDeck godoc
 //
 //	@Summary		Delete a deck
-//	@Description	Delete a deck by ID
+//	@Description	Move a deck to trash. It can be restored until purged after the retention period.
+//	@Description	mode=delete (default) trashes cards with the deck, mode=move moves them to the target deck, mode=detach leaves them without a deck
 //	@Tags			decks
-//	@Param			id	path	string	true	"Deck ID"
+//	@Param			id		path	string	true	"Deck ID"
+//	@Param			mode	query	string	false	"What happens to cards: delete, move or detach"
+//	@Param			target	query	string	false	"Deck ID to move cards to, required for mode=move"
 //	@Success		200
-//	@Failure		400	{object}	model.ErrorResponse	"Bad Request - Invalid deck ID format"
+//	@Failure		400	{object}	model.ErrorResponse	"Bad Request - Invalid deck ID, mode or target deck"
+//	@Failure		403	{object}	model.ErrorResponse	"Forbidden - Deck belongs to another user"
 //	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to delete deck"
-//	@Router			/deck/{id} [delete]
+//	@Router			/decks/{id} [delete]
 func (cc *Controller) DeleteDeck(ctx *gin.Context) {
 	deckId := ctx.Param("id")
 
@@ -150,21 +215,86 @@ func (cc *Controller) DeleteDeck(ctx *gin.Context) {
 		return
 	}
 
-	err = cc.deckClient.DeleteDeck(ctx, dId)
+	var targetId uuid.UUID
+	if target := ctx.Query("target"); target != "" {
+		if targetId, err = uuid.Parse(target); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid target deck ID"})
+			return
+		}
+	}
+
+	err = cc.deckClient.DeleteDeck(ctx, dId, ctx.Query("mode"), targetId)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete deck"})
+		abortWithCardError(ctx, err)
 		return
 	}
 	ctx.Status(http.StatusOK)
 }
 
+// RestoreDeck godoc
+//
+//	@Summary		Restore a deck
+//	@Description	Take a deck out of trash together with cards deleted along with it
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		200	{object}	model.Deck
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/restore [post]
+func (cc *Controller) RestoreDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	deck, err := cc.deckClient.RestoreDeck(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deck)
+}
+
+// CloneDeck godoc
+//
+//	@Summary		Clone a deck
+//	@Description	Copy a public deck (or an own one) with its options and cards into the user's collection.
+//	@Description	The copy is private, its cards start as new and it keeps source_deck_id of the original
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		201	{object}	model.Deck
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/clone [post]
+func (cc *Controller) CloneDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	deck, err := cc.deckClient.CloneDeck(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, deck)
+}
+
 // AddCardToDeck godoc
 //
 //	@Summary		Add card to deck
 //	@Description	Add a card to a specific deck. Is_public will be updated to deck's is_public
 //	@Tags			decks
+//	@Param			id		path	string	true	"Deck ID"
 //	@Param			card_id	path	string	true	"Card ID"
-//	@Param			deck_id	path	string	true	"Deck ID"
 //	@Success		200
 //	@Failure		400	{object}	model.ErrorResponse	"Bad Request - Invalid card ID or deck ID format"
 //	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to add card to deck"
@@ -176,7 +306,7 @@ func (cc *Controller) AddCardToDeck(ctx *gin.Context) {
 		return
 	}
 
-	did, err := uuid.Parse(ctx.Param("deck_id"))
+	did, err := uuid.Parse(ctx.Param("id"))
 	if err != nil {
 		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid deck ID"})
 		return
@@ -184,7 +314,39 @@ func (cc *Controller) AddCardToDeck(ctx *gin.Context) {
 
 	err = cc.deckClient.AddCardToDeck(ctx, did, cid)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add card to deck"})
+		abortWithCardError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// RemoveCardFromDeck godoc
+//
+//	@Summary		Remove card from deck
+//	@Description	Take a card out of a deck, the card stays without a deck and the deck's card count goes down
+//	@Tags			decks
+//	@Param			id		path	string	true	"Deck ID"
+//	@Param			card_id	path	string	true	"Card ID"
+//	@Success		200
+//	@Failure		400	{object}	map[string]string	"Invalid IDs or card is not in the deck"
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/cards/{card_id} [delete]
+func (cc *Controller) RemoveCardFromDeck(ctx *gin.Context) {
+	cid, err := uuid.Parse(ctx.Param("card_id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid card ID"})
+		return
+	}
+
+	did, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid deck ID"})
+		return
+	}
+
+	if err := cc.deckClient.RemoveCardFromDeck(ctx, did, cid); err != nil {
+		abortWithCardError(ctx, err)
 		return
 	}
 	ctx.Status(http.StatusOK)
@@ -195,10 +357,13 @@ func (cc *Controller) AddCardToDeck(ctx *gin.Context) {
 //	@Summary		Get cards from deck
 //	@Description	Retrieve all cards from a specific deck
 //	@Tags			decks
-//	@Param			id	path		string	true	"Deck ID"
-//	@Success		200	{array}		model.Card
-//	@Failure		400	{object}	model.ErrorResponse	"Bad Request - Invalid deck ID format"
-//	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to get cards from deck"
+//	@Param			id		path		string	true	"Deck ID"
+//	@Param			cursor	query		string	false	"Cursor returned in X-Next-Cursor of the previous page"
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
+//	@Success		200		{array}		model.Card
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page, absent on the last page"
+//	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid deck ID format"
+//	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to get cards from deck"
 //	@Router			/deck/{id}/cards [get]
 func (cc *Controller) ReadCardsFromDeck(ctx *gin.Context) {
 	did, err := uuid.Parse(ctx.Param("id"))
@@ -207,9 +372,77 @@ func (cc *Controller) ReadCardsFromDeck(ctx *gin.Context) {
 		return
 	}
 
-	response, err := cc.deckClient.ReadCardsFromDeck(ctx, did)
+	cursor, limit, err := getPageFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, next, err := cc.deckClient.ReadCardsFromDeck(ctx, did, cursor, limit)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get cards from deck"})
+		abortWithCardError(ctx, err)
+		return
+	}
+	setNextCursor(ctx, next)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ReadDeckOptions godoc
+//
+//	@Summary		Get deck options
+//	@Description	Retrieve study settings of the deck: learning steps in minutes, intervals in days
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		200	{object}	model.Options
+//	@Failure		400	{object}	model.ErrorResponse	"Bad Request - Invalid deck ID format"
+//	@Failure		500	{object}	model.ErrorResponse	"Internal Server Error - Failed to read deck options"
+//	@Router			/decks/{id}/options [get]
+func (cc *Controller) ReadDeckOptions(ctx *gin.Context) {
+	did, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid deck ID format"})
+		return
+	}
+
+	response, err := cc.deckClient.ReadDeckOptions(ctx, did)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read deck options"})
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateDeckOptions godoc
+//
+//	@Summary		Update deck options
+//	@Description	Replace study settings of the deck. Omitted intervals fall back to defaults
+//	@Tags			decks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string			true	"Deck ID"
+//	@Param			options	body		model.Options	true	"Deck options"
+//	@Success		200		{object}	model.Options
+//	@Failure		400		{object}	model.ErrorResponse	"Bad Request - Invalid deck ID format or request body"
+//	@Failure		500		{object}	model.ErrorResponse	"Internal Server Error - Failed to update deck options"
+//	@Router			/decks/{id}/options [put]
+func (cc *Controller) UpdateDeckOptions(ctx *gin.Context) {
+	did, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid deck ID format"})
+		return
+	}
+
+	var options model.Options
+	if err := ctx.ShouldBindJSON(&options); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+	options.DeckId = did
+
+	response, err := cc.deckClient.UpdateDeckOptions(ctx, &options)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+
+	modelCard "github.com/GOeda-Co/proto-contract/model/card"
+	"github.com/GOeda-Co/proto-contract/pagination"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/tomatoCoderq/repeatro/internal/lib/apkg"
+	"github.com/tomatoCoderq/repeatro/internal/lib/deckjson"
+)
+
+// ExportDeck godoc
+//
+//	@Summary		Export a deck
+//	@Description	Download the deck with all its cards as a JSON backup (format=json, default) or an Anki package (format=apkg).
+//	@Description	A JSON backup has the deck, its options and cards with tags, scheduling state unless scheduling=false
+//	@Description	and the user's review history if history=true. It is versioned, so later versions import it.
+//	@Description	Each card of an Anki package becomes a note with Front and Back fields, tags and scheduling are kept
+//	@Tags			decks
+//	@Produce		json,application/octet-stream
+//	@Param			id			path	string	true	"Deck ID"
+//	@Param			format		query	string	false	"json or apkg, json by default"
+//	@Param			scheduling	query	bool	false	"Keep scheduling state in JSON, true by default"
+//	@Param			history		query	bool	false	"Add review history to JSON, false by default"
+//	@Success		200			{file}	file
+//	@Failure		400			{object}	map[string]string
+//	@Failure		403			{object}	map[string]string
+//	@Router			/decks/{id}/export [get]
+func (cc *Controller) ExportDeck(ctx *gin.Context) {
+	did, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "apkg" {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "format must be json or apkg"})
+		return
+	}
+	scheduling, err := strconv.ParseBool(ctx.DefaultQuery("scheduling", "true"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "scheduling must be true or false"})
+		return
+	}
+	history, err := strconv.ParseBool(ctx.DefaultQuery("history", "false"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "history must be true or false"})
+		return
+	}
+
+	deck, err := cc.deckClient.ReadDeck(ctx, did)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+	cards, err := cc.readAllDeckCards(ctx, did)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	// Built in memory, so a failure still gets an error status
+	var file bytes.Buffer
+	if format == "apkg" {
+		if err := apkg.Write(&file, deck, cards); err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	} else {
+		options, err := cc.deckClient.ReadDeckOptions(ctx, did)
+		if err != nil {
+			abortWithCardError(ctx, err)
+			return
+		}
+		var reviews []schemes.Review
+		if history {
+			if reviews, err = cc.cardClient.ReadDeckReviews(ctx, did); err != nil {
+				abortWithCardError(ctx, err)
+				return
+			}
+		}
+		encoder := json.NewEncoder(&file)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(deckjson.New(deck, &options, cards, scheduling, reviews)); err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	contentType := "application/octet-stream"
+	if format == "json" {
+		contentType = "application/json"
+	}
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": deck.Name + "." + format})
+	ctx.Header("Content-Disposition", disposition)
+	ctx.Data(http.StatusOK, contentType, file.Bytes())
+}
+
+// readAllDeckCards reads cards of the deck page by page
+func (cc *Controller) readAllDeckCards(ctx *gin.Context, did uuid.UUID) ([]modelCard.Card, error) {
+	var cards []modelCard.Card
+	cursor := ""
+	for {
+		page, next, err := cc.deckClient.ReadCardsFromDeck(ctx, did, cursor, pagination.MaxLimit)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, page...)
+		if next == "" {
+			return cards, nil
+		}
+		cursor = next
+	}
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -85,6 +86,28 @@ func GetIsAdminFromContext(ctx *gin.Context) (bool, error) {
 	}
 }
 
+// getPageFromQuery reads the cursor and limit query params of list endpoints.
+// Limit 0 means the server default
+func getPageFromQuery(ctx *gin.Context) (string, int, error) {
+	cursor := ctx.Query("cursor")
+	limitParam := ctx.Query("limit")
+	if limitParam == "" {
+		return cursor, 0, nil
+	}
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 0 {
+		return "", 0, fmt.Errorf("invalid limit: %s", limitParam)
+	}
+	return cursor, limit, nil
+}
+
+// setNextCursor exposes the cursor of the next page, if there is one
+func setNextCursor(ctx *gin.Context, next string) {
+	if next != "" {
+		ctx.Header("X-Next-Cursor", next)
+	}
+}
+
 // type ErrorResponse struct {
 // 	Error string `json:"error"`
 // }
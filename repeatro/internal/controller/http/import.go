@@ -0,0 +1,232 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/tomatoCoderq/repeatro/internal/lib/apkg"
+	"github.com/tomatoCoderq/repeatro/internal/lib/csvimport"
+	"github.com/tomatoCoderq/repeatro/internal/lib/deckjson"
+)
+
+// maxImportSize limits size of an uploaded CSV or TSV file in bytes
+const maxImportSize = 10 << 20
+
+// maxPackageSize limits size of an uploaded Anki package, which may have media
+const maxPackageSize = 200 << 20
+
+// maxBackupSize limits size of a JSON deck backup, which may have review history
+const maxBackupSize = 50 << 20
+
+// importBatchSize is how many rows are sent to card service at once
+const importBatchSize = 100
+
+// rowReader reads rows of an uploaded file in batches, io.EOF at the end
+type rowReader interface {
+	Next(n int) ([]schemes.ImportRow, error)
+}
+
+// ImportCards godoc
+//
+//	@Summary		Import cards into a deck
+//	@Description	Add cards to the deck from a CSV or TSV file or an Anki package (.apkg).
+//	@Description	Columns of CSV and TSV are mapped by header name or 1-based position, by default word (or front),
+//	@Description	translation (or back) and optional tags, separated by commas or spaces.
+//	@Description	Notes of an Anki package become cards of their first two fields with tags and scheduling, media is skipped.
+//	@Description	Invalid rows and rows repeating a card of the deck are skipped and reported by line (note number for packages)
+//	@Tags			decks
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id			path		string	true	"Deck ID"
+//	@Param			file		formData	file	true	"CSV, TSV or apkg file"
+//	@Param			format		query		string	false	"csv, tsv or apkg, by default taken from the file extension"
+//	@Param			header		query		bool	false	"First row names the columns, true by default"
+//	@Param			word		query		string	false	"Column of words"
+//	@Param			translation	query		string	false	"Column of translations"
+//	@Param			tags		query		string	false	"Column of tags"
+//	@Success		200			{object}	schemes.ImportResult
+//	@Failure		400			{object}	map[string]string
+//	@Failure		403			{object}	map[string]string
+//	@Failure		404			{object}	map[string]string
+//	@Router			/decks/{id}/import [post]
+func (cc *Controller) ImportCards(ctx *gin.Context) {
+	did, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	opts := csvimport.Options{
+		Header: true,
+		Mapping: csvimport.Mapping{
+			Word:        ctx.Query("word"),
+			Translation: ctx.Query("translation"),
+			Tags:        ctx.Query("tags"),
+		},
+	}
+	if header := ctx.Query("header"); header != "" {
+		if opts.Header, err = strconv.ParseBool(header); err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "header must be true or false"})
+			return
+		}
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxPackageSize)
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "file is required: " + err.Error()})
+		return
+	}
+
+	format := ctx.Query("format")
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+	switch format {
+	case "apkg":
+	case "tsv", "tab":
+		opts.Comma = '\t'
+	case "csv", "txt", "":
+		opts.Comma = ','
+	default:
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "format must be csv, tsv or apkg"})
+		return
+	}
+	if format != "apkg" && fileHeader.Size > maxImportSize {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "file is larger than 10 MB"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	var reader rowReader
+	if format == "apkg" {
+		pkg, err := apkg.Open(file, fileHeader.Size)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer pkg.Close()
+		reader = pkg
+	} else {
+		reader, err = csvimport.NewReader(file, opts)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	// Errors of reading the file abort the import and are the client's fault
+	var readErr error
+	next := func() ([]schemes.ImportRow, error) {
+		rows, err := reader.Next(importBatchSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			readErr = err
+		}
+		return rows, err
+	}
+
+	result, err := cc.cardClient.ImportCards(ctx, did, next)
+	if readErr != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": readErr.Error()})
+		return
+	}
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// ImportDeck godoc
+//
+//	@Summary		Import a deck
+//	@Description	Create a new deck from a JSON backup made by export, sent as the body or as a multipart file.
+//	@Description	Options, tags, scheduling state and review history of the backup are kept.
+//	@Description	Backups of older versions are accepted, newer ones are rejected.
+//	@Description	Invalid cards are skipped and reported by their number in the backup
+//	@Tags			decks
+//	@Accept			json,multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file	false	"JSON backup"
+//	@Success		201		{object}	map[string]interface{}	"deck and result of importing its cards"
+//	@Failure		400		{object}	map[string]string
+//	@Router			/decks/import [post]
+func (cc *Controller) ImportDeck(ctx *gin.Context) {
+	userId, err := GetUserIdFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user ID"})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxBackupSize)
+	var body io.Reader = ctx.Request.Body
+	if strings.HasPrefix(ctx.ContentType(), "multipart/") {
+		fileHeader, err := ctx.FormFile("file")
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "file is required: " + err.Error()})
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	doc, err := deckjson.Decode(body)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deck := doc.ModelDeck()
+	deck.CreatedBy = userId
+	deck, err = cc.deckClient.AddDeck(ctx, &deck)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	// A half imported deck is of no use, it goes to trash and the error is returned
+	result, err := cc.importDeckCards(ctx, deck.DeckId, doc)
+	if err != nil {
+		if err := cc.deckClient.DeleteDeck(ctx, deck.DeckId, modelDeck.DeleteModeCards, uuid.Nil); err != nil {
+			cc.log.Error("failed to delete partly imported deck", slog.String("deck_id", deck.DeckId.String()), slog.Any("error", err))
+		}
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"deck": deck, "result": result})
+}
+
+func (cc *Controller) importDeckCards(ctx *gin.Context, did uuid.UUID, doc *deckjson.Document) (*schemes.ImportResult, error) {
+	if options := doc.ModelOptions(did); options != nil {
+		if _, err := cc.deckClient.UpdateDeckOptions(ctx, options); err != nil {
+			return nil, err
+		}
+	}
+	rows := doc.Rows()
+	return cc.cardClient.ImportCards(ctx, did, func() ([]schemes.ImportRow, error) {
+		return rows.Next(importBatchSize)
+	})
+}
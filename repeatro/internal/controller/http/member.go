@@ -0,0 +1,110 @@
+package http
+
+import (
+	"net/http"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReadDeckMembers godoc
+//
+//	@Summary		Members of a deck
+//	@Description	Users sharing the deck with its author and their roles, first invited first
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		200	{array}		model.Member
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Router			/decks/{id}/members [get]
+func (cc *Controller) ReadDeckMembers(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	members, err := cc.deckClient.ReadDeckMembers(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, members)
+}
+
+// InviteDeckMember godoc
+//
+//	@Summary		Invite a user to a deck
+//	@Description	Give the user a role in the deck, or change the role of a member. Viewers read the deck,
+//	@Description	editors also add, edit and remove its cards, owners also change the deck and manage members.
+//	@Description	Only owners invite
+//	@Tags			decks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Deck ID"
+//	@Param			user_id	path		string						true	"ID of the user to invite"
+//	@Param			role	body		schemes.DeckMemberScheme	true	"Role of the user"
+//	@Success		200		{object}	model.Member
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Router			/decks/{id}/members/{user_id} [put]
+func (cc *Controller) InviteDeckMember(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+	userId, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+	var body schemes.DeckMemberScheme
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member, err := cc.deckClient.InviteDeckMember(ctx, deckId, userId, body.Role)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, member)
+}
+
+// RemoveDeckMember godoc
+//
+//	@Summary		Remove a member from a deck
+//	@Description	Owners remove any member, other members can only leave the deck by removing themselves
+//	@Tags			decks
+//	@Param			id		path	string	true	"Deck ID"
+//	@Param			user_id	path	string	true	"ID of the member"
+//	@Success		200
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/members/{user_id} [delete]
+func (cc *Controller) RemoveDeckMember(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+	userId, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := cc.deckClient.RemoveDeckMember(ctx, deckId, userId); err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
@@ -0,0 +1,211 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SubscribeDeck godoc
+//
+//	@Summary		Subscribe to a shared deck
+//	@Description	Clone a public deck of another user as a private copy that follows its updates.
+//	@Description	The copy starts at the last published version, later versions are merged by sync
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"ID of the shared deck"
+//	@Success		201	{object}	model.Deck
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/subscription [post]
+func (cc *Controller) SubscribeDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	deck, err := cc.deckClient.SubscribeDeck(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, deck)
+}
+
+// UnsubscribeDeck godoc
+//
+//	@Summary		Unsubscribe from a shared deck
+//	@Description	Stop merging updates into the subscribed copy, the copy and its cards stay
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"ID of the subscribed copy"
+//	@Success		200	{object}	model.Deck
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		409	{object}	map[string]string	"Deck is not subscribed"
+//	@Router			/decks/{id}/subscription [delete]
+func (cc *Controller) UnsubscribeDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	deck, err := cc.deckClient.UnsubscribeDeck(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deck)
+}
+
+// SyncDeck godoc
+//
+//	@Summary		Merge updates of a shared deck
+//	@Description	Merge cards added, edited and deleted in versions of the shared deck published since the last sync
+//	@Description	into the subscribed copy. Scheduling of the copied cards is kept. Cards edited by the subscriber
+//	@Description	or marked keep_local, and cards the subscriber deleted, are left as they are and counted as kept
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"ID of the subscribed copy"
+//	@Success		200	{object}	schemes.DeckSyncResult
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Failure		409	{object}	map[string]string	"Deck is not subscribed"
+//	@Router			/decks/{id}/sync [post]
+func (cc *Controller) SyncDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	result, err := cc.cardClient.SyncDeck(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// PublishDeck godoc
+//
+//	@Summary		Publish a new version of a deck
+//	@Description	Record cards added, edited and deleted since the last version as a new version of the own deck,
+//	@Description	so subscribers can merge them. The version stays when nothing changed
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		200	{object}	schemes.PublishResult
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/publish [post]
+func (cc *Controller) PublishDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	result, err := cc.cardClient.PublishDeck(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// ReadDeckChangelog godoc
+//
+//	@Summary		Changelog of a shared deck
+//	@Description	Versions of a public or own deck published after the given one, oldest first, with changed cards
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id		path		string	true	"Deck ID"
+//	@Param			since	query		int		false	"Only versions after this one, 0 by default"
+//	@Success		200		{object}	schemes.DeckChangelog
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string
+//	@Router			/decks/{id}/changelog [get]
+func (cc *Controller) ReadDeckChangelog(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+	since, err := strconv.Atoi(ctx.DefaultQuery("since", "0"))
+	if err != nil || since < 0 {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "since must be a version number"})
+		return
+	}
+
+	changes, version, err := cc.cardClient.ReadDeckChanges(ctx, deckId, since)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	// Changes come ordered by version
+	changelog := schemes.DeckChangelog{Version: version, Versions: []schemes.DeckVersion{}}
+	for _, change := range changes {
+		last := len(changelog.Versions) - 1
+		if last < 0 || changelog.Versions[last].Version != change.Version {
+			changelog.Versions = append(changelog.Versions, schemes.DeckVersion{
+				Version:     change.Version,
+				PublishedAt: change.PublishedAt,
+			})
+			last++
+		}
+		changelog.Versions[last].Changes = append(changelog.Versions[last].Changes, change)
+	}
+
+	ctx.JSON(http.StatusOK, changelog)
+}
+
+// SetKeepLocal godoc
+//
+//	@Summary		Keep local version of a cloned card
+//	@Description	With keep_local set, changes of the source card are no longer merged into the card.
+//	@Description	Editing content of a cloned card sets it, clearing it takes upstream changes again
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string					true	"Card ID"
+//	@Param			keep_local	body		schemes.KeepLocalScheme	true	"Whether to keep the local version"
+//	@Success		200			{object}	model.Card
+//	@Failure		400			{object}	map[string]string
+//	@Failure		403			{object}	map[string]string
+//	@Failure		404			{object}	map[string]string
+//	@Router			/cards/{id}/keep-local [put]
+func (cc *Controller) SetKeepLocal(ctx *gin.Context) {
+	cardId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid card ID"})
+		return
+	}
+	var body schemes.KeepLocalScheme
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	card, err := cc.cardClient.SetKeepLocal(ctx, cardId, body.KeepLocal)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, card)
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"net/http"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/gin-gonic/gin"
+)
+
+// ReadTags godoc
+//
+//	@Summary		List tags
+//	@Description	List tags of the user with the number of cards having each, most used first
+//	@Tags			tags
+//	@Produce		json
+//	@Success		200	{array}		model.TagCount
+//	@Failure		500	{object}	map[string]string
+//	@Router			/tags [get]
+func (cc *Controller) ReadTags(ctx *gin.Context) {
+	tags, err := cc.cardClient.ReadTags(ctx)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tags)
+}
+
+// RenameTag godoc
+//
+//	@Summary		Rename a tag
+//	@Description	Rename the tag on every card of the user. Renaming to an existing tag merges both
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			tag		path		string					true	"Tag"
+//	@Param			rename	body		schemes.RenameTagScheme	true	"New name"
+//	@Success		200		{object}	map[string]int64
+//	@Failure		400		{object}	map[string]string
+//	@Router			/tags/{tag} [put]
+func (cc *Controller) RenameTag(ctx *gin.Context) {
+	var rename schemes.RenameTagScheme
+	if err := ctx.ShouldBindJSON(&rename); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := cc.cardClient.RenameTag(ctx, ctx.Param("tag"), rename.Name)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"cards_count": count})
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReadTrash godoc
+//
+//	@Summary		Trash contents
+//	@Description	List cards and decks of the user in trash, recently deleted first. Items are purged after the retention period
+//	@Tags			trash
+//	@Produce		json
+//	@Success		200	{object}	map[string]interface{}
+//	@Failure		500	{object}	map[string]string
+//	@Router			/trash [get]
+func (cc *Controller) ReadTrash(ctx *gin.Context) {
+	cards, err := cc.cardClient.ReadTrashedCards(ctx)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	decks, err := cc.deckClient.ReadTrashedDecks(ctx)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"cards": cards, "decks": decks})
+}
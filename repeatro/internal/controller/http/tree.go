@@ -0,0 +1,107 @@
+package http
+
+import (
+	"net/http"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReadDeckTree godoc
+//
+//	@Summary		Deck with its subdecks
+//	@Description	The deck and all decks nested under it, oldest first. parent_deck_id links them into a tree.
+//	@Description	Subdecks the user can't read are left out together with decks below them
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		200	{array}		model.Deck
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/tree [get]
+func (cc *Controller) ReadDeckTree(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	decks, err := cc.deckClient.ReadDeckTree(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, decks)
+}
+
+// MoveDeck godoc
+//
+//	@Summary		Move a deck in the tree
+//	@Description	Nest the deck with all of its subdecks under another deck of the same author, or make it top-level
+//	@Description	with a null parent_deck_id. A deck can't be nested under one of its own subdecks
+//	@Tags			decks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Deck ID"
+//	@Param			parent	body		schemes.MoveDeckScheme	true	"New parent deck"
+//	@Success		200		{object}	model.Deck
+//	@Failure		400		{object}	map[string]string
+//	@Failure		403		{object}	map[string]string
+//	@Failure		404		{object}	map[string]string
+//	@Router			/decks/{id}/parent [put]
+func (cc *Controller) MoveDeck(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+	var body schemes.MoveDeckScheme
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	parentId := uuid.Nil
+	if body.ParentDeckId != nil {
+		parentId = *body.ParentDeckId
+	}
+	deck, err := cc.deckClient.MoveDeck(ctx, deckId, parentId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deck)
+}
+
+// ReadDeckDueCounts godoc
+//
+//	@Summary		Due cards in a deck tree
+//	@Description	Cards of the user due today by phase in the deck and each of its subdecks, parents first.
+//	@Description	total_* fields add up the deck with all subdecks below it. Daily limits are not applied
+//	@Tags			decks
+//	@Produce		json
+//	@Param			id	path		string	true	"Deck ID"
+//	@Success		200	{array}		model.DueCount
+//	@Failure		400	{object}	map[string]string
+//	@Failure		403	{object}	map[string]string
+//	@Failure		404	{object}	map[string]string
+//	@Router			/decks/{id}/due [get]
+func (cc *Controller) ReadDeckDueCounts(ctx *gin.Context) {
+	deckId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid deck ID"})
+		return
+	}
+
+	counts, err := cc.deckClient.ReadDeckDueCounts(ctx, deckId)
+	if err != nil {
+		abortWithCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, counts)
+}
@@ -0,0 +1,278 @@
+// Package apkg reads and writes Anki packages: zip archives with a SQLite
+// collection of notes and cards, and media files
+package apkg
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/klauspost/compress/zstd"
+	_ "modernc.org/sqlite"
+)
+
+// Collections in order of preference. Recent Anki versions put the collection into
+// zstd-compressed anki21b and a stub asking to update Anki into anki2
+var collectionNames = []string{"collection.anki21b", "collection.anki21", "collection.anki2"}
+
+// maxCollectionSize limits the unpacked collection, so a small archive can't fill the disk
+const maxCollectionSize = 512 << 20
+
+// Fields of a note are stored in one column separated by this character
+const fieldSeparator = "\x1f"
+
+// Types of Anki cards
+const (
+	cardTypeNew        = 0
+	cardTypeLearning   = 1
+	cardTypeReview     = 2
+	cardTypeRelearning = 3
+)
+
+var (
+	ErrNoCollection  = errors.New("package has no Anki collection")
+	ErrTooLarge      = fmt.Errorf("collection is larger than %d MB", maxCollectionSize>>20)
+	ErrBadCollection = errors.New("collection is not a valid Anki collection")
+)
+
+// ankiCard is scheduling state of an Anki card
+type ankiCard struct {
+	Type   int
+	Queue  int
+	Due    int64
+	Ivl    int
+	Factor int
+	Reps   int
+	Lapses int
+	Data   string
+}
+
+// Reader reads notes of a package as rows to import, Line of a row is the number of
+// the note. A note becomes one card: its first field is the word, the second one the
+// translation and scheduling comes from its first card. Media files are not imported,
+// references to them are dropped from fields
+type Reader struct {
+	dir     string
+	db      *sql.DB
+	rows    *sql.Rows
+	created time.Time // day the collection was created, due of review cards counts from it
+	note    int
+}
+
+func Open(r io.ReaderAt, size int64) (*Reader, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := findCollection(archive)
+	if collection == nil {
+		return nil, ErrNoCollection
+	}
+
+	dir, err := os.MkdirTemp("", "apkg")
+	if err != nil {
+		return nil, err
+	}
+	reader := &Reader{dir: dir}
+
+	path := filepath.Join(dir, "collection.db")
+	if err := extract(collection, path); err != nil {
+		reader.Close()
+		return nil, err
+	}
+
+	reader.db, err = sql.Open("sqlite", "file:"+path+"?mode=ro")
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	if err := reader.query(); err != nil {
+		reader.Close()
+		return nil, fmt.Errorf("%w: %v", ErrBadCollection, err)
+	}
+	return reader, nil
+}
+
+func findCollection(archive *zip.Reader) *zip.File {
+	for _, name := range collectionNames {
+		for _, file := range archive.File {
+			if file.Name == name {
+				return file
+			}
+		}
+	}
+	return nil
+}
+
+// extract unpacks the collection to path, decompressing it if needed
+func extract(file *zip.File, path string) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	var collection io.Reader = src
+	if strings.HasSuffix(file.Name, ".anki21b") {
+		decoder, err := zstd.NewReader(src)
+		if err != nil {
+			return err
+		}
+		defer decoder.Close()
+		collection = decoder
+	}
+
+	dst, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	written, err := io.Copy(dst, io.LimitReader(collection, maxCollectionSize+1))
+	if err != nil {
+		return err
+	}
+	if written > maxCollectionSize {
+		return ErrTooLarge
+	}
+	return dst.Close()
+}
+
+func (r *Reader) query() error {
+	var created int64
+	if err := r.db.QueryRow("SELECT crt FROM col").Scan(&created); err != nil {
+		return err
+	}
+	r.created = time.Unix(created, 0)
+
+	// Cards of filtered decks keep their own due in odue
+	rows, err := r.db.Query(`
+		SELECT n.flds, n.tags, c.type, c.queue,
+			CASE WHEN c.odid != 0 THEN c.odue ELSE c.due END,
+			c.ivl, c.factor, c.reps, c.lapses, c.data
+		FROM notes n
+		JOIN cards c ON c.id = (SELECT id FROM cards WHERE nid = n.id ORDER BY ord LIMIT 1)
+		ORDER BY n.id`)
+	if err != nil {
+		return err
+	}
+	r.rows = rows
+	return nil
+}
+
+// Next returns up to n rows and io.EOF once all notes are read
+func (r *Reader) Next(n int) ([]schemes.ImportRow, error) {
+	var rows []schemes.ImportRow
+	for len(rows) < n && r.rows.Next() {
+		var fields, tags string
+		var card ankiCard
+		err := r.rows.Scan(&fields, &tags, &card.Type, &card.Queue, &card.Due,
+			&card.Ivl, &card.Factor, &card.Reps, &card.Lapses, &card.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		r.note++
+		values := strings.Split(fields, fieldSeparator)
+		rows = append(rows, schemes.ImportRow{
+			Line:        r.note,
+			Word:        fieldText(values, 0),
+			Translation: fieldText(values, 1),
+			Tags:        strings.Fields(tags),
+			Schedule:    r.schedule(card),
+		})
+	}
+	if err := r.rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, io.EOF
+	}
+	return rows, nil
+}
+
+// schedule converts scheduling state of the card, nil for new cards. Due of
+// review cards counts days since the collection was created, (re)learning cards
+// due within a day have it as unix time and later ones in days as well
+func (r *Reader) schedule(card ankiCard) *schemes.ImportSchedule {
+	schedule := &schemes.ImportSchedule{
+		Interval:         max(card.Ivl, 0), // negative intervals are learning steps in seconds
+		Easiness:         float64(card.Factor) / 1000,
+		RepetitionNumber: card.Reps,
+		Lapses:           card.Lapses,
+	}
+	switch card.Type {
+	case cardTypeLearning:
+		schedule.Phase = "learning"
+	case cardTypeReview:
+		schedule.Phase = "review"
+	case cardTypeRelearning:
+		schedule.Phase = "relearning"
+	default:
+		return nil
+	}
+
+	if card.Type != cardTypeReview && card.Due > time.Now().AddDate(-30, 0, 0).Unix() {
+		schedule.ExpiresAt = time.Unix(card.Due, 0)
+	} else {
+		schedule.ExpiresAt = r.created.AddDate(0, 0, int(card.Due))
+	}
+	if card.Type == cardTypeReview && card.Ivl > 0 {
+		reviewed := schedule.ExpiresAt.AddDate(0, 0, -card.Ivl)
+		schedule.LastReviewedAt = &reviewed
+	}
+
+	// Memory state of cards scheduled with FSRS
+	var memory fsrsMemory
+	if json.Unmarshal([]byte(card.Data), &memory) == nil {
+		schedule.Stability = memory.Stability
+		schedule.Difficulty = memory.Difficulty
+	}
+	return schedule
+}
+
+// fsrsMemory is kept by Anki in data of a card
+type fsrsMemory struct {
+	Stability  float64 `json:"s,omitempty"`
+	Difficulty float64 `json:"d,omitempty"`
+}
+
+var (
+	soundTag  = regexp.MustCompile(`\[sound:[^\]]*\]`)
+	lineBreak = regexp.MustCompile(`(?i)<br\s*/?>|</div>|</p>|</li>`)
+	htmlTag   = regexp.MustCompile(`<[^>]*>`)
+)
+
+// fieldText turns HTML of a note field into plain text, dropping media references
+func fieldText(fields []string, i int) string {
+	if i >= len(fields) {
+		return ""
+	}
+	text := soundTag.ReplaceAllString(fields[i], "")
+	text = lineBreak.ReplaceAllString(text, " ")
+	text = htmlTag.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Close releases the collection and removes its unpacked copy
+func (r *Reader) Close() error {
+	if r.rows != nil {
+		r.rows.Close()
+	}
+	if r.db != nil {
+		r.db.Close()
+	}
+	return os.RemoveAll(r.dir)
+}
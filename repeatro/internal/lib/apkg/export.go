@@ -0,0 +1,312 @@
+package apkg
+
+import (
+	"archive/zip"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	modelCard "github.com/GOeda-Co/proto-contract/model/card"
+	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+)
+
+// Anki ids are milliseconds. The note type is the same in every export,
+// so importing several decks into Anki doesn't multiply note types
+const (
+	defaultDeckId = 1
+	noteTypeId    = 1718035200000
+)
+
+// Queues of Anki cards
+const (
+	queueNew      = 0
+	queueLearning = 1
+	queueReview   = 2
+)
+
+// schema of the legacy collection format, which every Anki version imports
+const schema = `
+CREATE TABLE col (
+	id integer PRIMARY KEY, crt integer NOT NULL, mod integer NOT NULL, scm integer NOT NULL,
+	ver integer NOT NULL, dty integer NOT NULL, usn integer NOT NULL, ls integer NOT NULL,
+	conf text NOT NULL, models text NOT NULL, decks text NOT NULL, dconf text NOT NULL, tags text NOT NULL
+);
+CREATE TABLE notes (
+	id integer PRIMARY KEY, guid text NOT NULL, mid integer NOT NULL, mod integer NOT NULL,
+	usn integer NOT NULL, tags text NOT NULL, flds text NOT NULL, sfld integer NOT NULL,
+	csum integer NOT NULL, flags integer NOT NULL, data text NOT NULL
+);
+CREATE TABLE cards (
+	id integer PRIMARY KEY, nid integer NOT NULL, did integer NOT NULL, ord integer NOT NULL,
+	mod integer NOT NULL, usn integer NOT NULL, type integer NOT NULL, queue integer NOT NULL,
+	due integer NOT NULL, ivl integer NOT NULL, factor integer NOT NULL, reps integer NOT NULL,
+	lapses integer NOT NULL, left integer NOT NULL, odue integer NOT NULL, odid integer NOT NULL,
+	flags integer NOT NULL, data text NOT NULL
+);
+CREATE TABLE revlog (
+	id integer PRIMARY KEY, cid integer NOT NULL, usn integer NOT NULL, ease integer NOT NULL,
+	ivl integer NOT NULL, lastIvl integer NOT NULL, factor integer NOT NULL, time integer NOT NULL,
+	type integer NOT NULL
+);
+CREATE TABLE graves (usn integer NOT NULL, oid integer NOT NULL, type integer NOT NULL);
+CREATE INDEX ix_notes_usn ON notes (usn);
+CREATE INDEX ix_cards_usn ON cards (usn);
+CREATE INDEX ix_revlog_usn ON revlog (usn);
+CREATE INDEX ix_cards_nid ON cards (nid);
+CREATE INDEX ix_cards_sched ON cards (did, queue, due);
+CREATE INDEX ix_revlog_cid ON revlog (cid);
+CREATE INDEX ix_notes_csum ON notes (csum);
+`
+
+// Write writes the deck with its cards as an Anki package. Each card becomes
+// a note with Front and Back fields, its scheduling state is kept
+func Write(w io.Writer, deck modelDeck.Deck, cards []modelCard.Card) error {
+	dir, err := os.MkdirTemp("", "apkg")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "collection.anki2")
+	if err := writeCollection(path, deck, cards); err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	file, err := archive.Create("collection.anki2")
+	if err != nil {
+		return err
+	}
+	collection, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer collection.Close()
+	if _, err := io.Copy(file, collection); err != nil {
+		return err
+	}
+
+	// Cards hold text only, so there is no media
+	media, err := archive.Create("media")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(media, "{}"); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+func writeCollection(path string, deck modelDeck.Deck, cards []modelCard.Card) error {
+	db, err := sql.Open("sqlite", "file:"+path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(schema); err != nil {
+		return err
+	}
+
+	// Oldest cards first, so they keep their order as new cards
+	cards = slices.Clone(cards)
+	slices.SortStableFunc(cards, func(a, b modelCard.Card) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	now := time.Now()
+	created := collectionCreated(now, cards)
+	deckId := max(deck.CreatedAt.UnixMilli(), defaultDeckId+1)
+
+	conf, models, decks, dconf, err := collectionConfig(now, deck, deckId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT INTO col VALUES (1, ?, ?, ?, 11, 0, 0, 0, ?, ?, ?, ?, '{}')",
+		created.Unix(), now.UnixMilli(), now.UnixMilli(), conf, models, decks, dconf)
+	if err != nil {
+		return err
+	}
+
+	var id int64
+	for position, card := range cards {
+		// Ids must be unique, cards imported together share creation time
+		id = max(id+1, card.CreatedAt.UnixMilli())
+		modified := card.UpdatedAt
+		if modified.IsZero() {
+			modified = now
+		}
+
+		tags := ""
+		if len(card.Tags) > 0 {
+			tags = " " + strings.Join(card.Tags, " ") + " "
+		}
+		fields := html.EscapeString(card.Word) + fieldSeparator + html.EscapeString(card.Translation)
+		_, err := tx.Exec("INSERT INTO notes VALUES (?, ?, ?, ?, -1, ?, ?, ?, ?, 0, '')",
+			id, card.CardId.String(), noteTypeId, modified.Unix(), tags, fields, card.Word, checksum(card.Word))
+		if err != nil {
+			return err
+		}
+
+		ankiCard := toAnkiCard(card, position, created)
+		_, err = tx.Exec("INSERT INTO cards VALUES (?, ?, ?, 0, ?, -1, ?, ?, ?, ?, ?, ?, ?, ?, 0, 0, 0, ?)",
+			id, id, deckId, modified.Unix(), ankiCard.Type, ankiCard.Queue, ankiCard.Due,
+			ankiCard.Ivl, ankiCard.Factor, ankiCard.Reps, ankiCard.Lapses, learningLeft(ankiCard), ankiCard.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// collectionCreated is the day review cards count their due from, no later than
+// the earliest due review so that due days are never negative
+func collectionCreated(now time.Time, cards []modelCard.Card) time.Time {
+	created := now
+	for _, card := range cards {
+		if card.Phase == "review" && card.ExpiresAt.Before(created) {
+			created = card.ExpiresAt
+		}
+	}
+	year, month, day := created.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func toAnkiCard(card modelCard.Card, position int, created time.Time) ankiCard {
+	result := ankiCard{
+		Ivl:    card.Interval,
+		Factor: int(card.Easiness * 1000),
+		Reps:   card.RepetitionNumber,
+		Lapses: card.Lapses,
+		Due:    card.ExpiresAt.Unix(),
+		Queue:  queueLearning,
+		Data:   "",
+	}
+	if card.Stability > 0 {
+		data, _ := json.Marshal(fsrsMemory{Stability: card.Stability, Difficulty: card.Difficulty})
+		result.Data = string(data)
+	}
+
+	switch card.Phase {
+	case "learning":
+		result.Type = cardTypeLearning
+		result.Ivl = 0
+	case "relearning":
+		result.Type = cardTypeRelearning
+	case "review":
+		result.Type = cardTypeReview
+		result.Queue = queueReview
+		result.Ivl = max(card.Interval, 1)
+		result.Due = int64(card.ExpiresAt.Sub(created).Hours() / 24)
+	default:
+		return ankiCard{Type: cardTypeNew, Queue: queueNew, Due: int64(position + 1)}
+	}
+	return result
+}
+
+// learningLeft tells Anki one learning step is left today, (re)learning cards need it
+func learningLeft(card ankiCard) int {
+	if card.Queue == queueLearning {
+		return 1001
+	}
+	return 0
+}
+
+// checksum of the first field lets Anki find duplicate notes
+func checksum(text string) int64 {
+	sum := sha1.Sum([]byte(text))
+	return int64(binary.BigEndian.Uint32(sum[:4]))
+}
+
+// collectionConfig returns JSON of collection settings, note types, decks and deck options
+func collectionConfig(now time.Time, deck modelDeck.Deck, deckId int64) (conf, models, decks, dconf string, err error) {
+	mod := now.Unix()
+	values := []any{
+		map[string]any{
+			"activeDecks": []int64{deckId}, "curDeck": deckId, "newSpread": 0, "collapseTime": 1200,
+			"timeLim": 0, "estTimes": true, "dueCounts": true, "curModel": strconv.Itoa(noteTypeId),
+			"nextPos": 1, "sortType": "noteFld", "sortBackwards": false, "addToCur": true,
+		},
+		map[string]any{
+			strconv.Itoa(noteTypeId): map[string]any{
+				"id": noteTypeId, "name": "Repeatro", "type": 0, "mod": mod, "usn": -1,
+				"sortf": 0, "did": deckId, "tags": []string{}, "vers": []int{},
+				"flds": []map[string]any{
+					noteField("Front", 0),
+					noteField("Back", 1),
+				},
+				"tmpls": []map[string]any{{
+					"name": "Card 1", "ord": 0, "did": nil, "bqfmt": "", "bafmt": "", "bfont": "", "bsize": 0,
+					"qfmt": "{{Front}}",
+					"afmt": "{{FrontSide}}\n\n<hr id=answer>\n\n{{Back}}",
+				}},
+				"css":       ".card {\n font-family: arial;\n font-size: 20px;\n text-align: center;\n color: black;\n background-color: white;\n}\n",
+				"latexPre":  "\\documentclass[12pt]{article}\n\\special{papersize=3in,5in}\n\\usepackage[utf8]{inputenc}\n\\usepackage{amssymb,amsmath}\n\\pagestyle{empty}\n\\setlength{\\parindent}{0in}\n\\begin{document}\n",
+				"latexPost": "\\end{document}",
+				"latexsvg":  false,
+				"req":       []any{[]any{0, "any", []int{0}}},
+			},
+		},
+		map[string]any{
+			strconv.Itoa(defaultDeckId):   ankiDeck(defaultDeckId, "Default", "", mod),
+			strconv.FormatInt(deckId, 10): ankiDeck(deckId, deck.Name, deck.Description, mod),
+		},
+		map[string]any{
+			strconv.Itoa(defaultDeckId): map[string]any{
+				"id": defaultDeckId, "name": "Default", "mod": 0, "usn": 0, "maxTaken": 60,
+				"autoplay": true, "timer": 0, "replayq": true, "dyn": false,
+				"new": map[string]any{
+					"perDay": 20, "delays": []int{1, 10}, "ints": []int{1, 4, 7}, "initialFactor": 2500,
+					"separate": true, "order": 1, "bury": true,
+				},
+				"rev": map[string]any{
+					"perDay": 200, "ease4": 1.3, "fuzz": 0.05, "ivlFct": 1, "maxIvl": 36500,
+					"minSpace": 1, "bury": true,
+				},
+				"lapse": map[string]any{
+					"delays": []int{10}, "mult": 0, "minInt": 1, "leechFails": 8, "leechAction": 0,
+				},
+			},
+		},
+	}
+
+	encoded := make([]string, len(values))
+	for i, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", "", "", "", err
+		}
+		encoded[i] = string(data)
+	}
+	return encoded[0], encoded[1], encoded[2], encoded[3], nil
+}
+
+func noteField(name string, ord int) map[string]any {
+	return map[string]any{
+		"name": name, "ord": ord, "font": "Arial", "size": 20, "media": []string{}, "rtl": false, "sticky": false,
+	}
+}
+
+func ankiDeck(id int64, name, description string, mod int64) map[string]any {
+	return map[string]any{
+		"id": id, "name": name, "desc": description, "mod": mod, "usn": -1, "conf": defaultDeckId,
+		"dyn": 0, "collapsed": false, "extendNew": 10, "extendRev": 50,
+		"newToday": []int{0, 0}, "revToday": []int{0, 0}, "lrnToday": []int{0, 0}, "timeToday": []int{0, 0},
+	}
+}
@@ -0,0 +1,158 @@
+// Package csvimport reads cards to import from CSV and TSV files
+package csvimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+)
+
+// Mapping tells which columns hold fields of a card. A column is given by its
+// header name or 1-based position, an empty column means the default one
+type Mapping struct {
+	Word        string
+	Translation string
+	Tags        string
+}
+
+type Options struct {
+	Comma   rune // ',' when zero
+	Header  bool // first row names the columns
+	Mapping Mapping
+}
+
+// Default column names, used when the file has a header and mapping does not name them
+var defaultNames = map[string][]string{
+	"word":        {"word", "front"},
+	"translation": {"translation", "back"},
+	"tags":        {"tags", "tag"},
+}
+
+var ErrNoColumn = errors.New("column not found")
+
+// Reader reads rows of a file in batches, keeping line numbers for error reports
+type Reader struct {
+	csv         *csv.Reader
+	word        int
+	translation int
+	tags        int // -1 when rows have no tags
+}
+
+func NewReader(r io.Reader, opts Options) (*Reader, error) {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var header []string
+	if opts.Header {
+		record, err := reader.Read()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		header = record
+		if len(header) > 0 {
+			// Spreadsheet exports often start with a byte order mark
+			header[0] = strings.TrimPrefix(header[0], "\ufeff")
+		}
+	}
+
+	word, err := column(header, opts.Mapping.Word, "word", 0)
+	if err != nil {
+		return nil, err
+	}
+	translation, err := column(header, opts.Mapping.Translation, "translation", 1)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := column(header, opts.Mapping.Tags, "tags", 2)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{csv: reader, word: word, translation: translation, tags: tags}, nil
+}
+
+// column resolves the mapped column to its index. Without mapping it is the
+// column with a default name or, in files without header, the default position
+func column(header []string, mapped string, field string, position int) (int, error) {
+	if mapped == "" {
+		if header == nil {
+			return position, nil
+		}
+		for i, name := range header {
+			for _, defaultName := range defaultNames[field] {
+				if strings.EqualFold(strings.TrimSpace(name), defaultName) {
+					return i, nil
+				}
+			}
+		}
+		// Tags are optional, a header without them means rows have no tags
+		if field == "tags" {
+			return -1, nil
+		}
+		return 0, fmt.Errorf("%w: %s", ErrNoColumn, field)
+	}
+
+	if index, err := strconv.Atoi(mapped); err == nil {
+		if index < 1 {
+			return 0, fmt.Errorf("%w: %s column must be a name or a position from 1", ErrNoColumn, field)
+		}
+		return index - 1, nil
+	}
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(mapped)) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrNoColumn, mapped)
+}
+
+// Next returns up to n rows and io.EOF once the file is read. Missing columns
+// are read as empty, so such rows are reported by the import instead of failing it
+func (r *Reader) Next(n int) ([]schemes.ImportRow, error) {
+	var rows []schemes.ImportRow
+	for len(rows) < n {
+		record, err := r.csv.Read()
+		if errors.Is(err, io.EOF) {
+			if len(rows) == 0 {
+				return nil, io.EOF
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := r.csv.FieldPos(0)
+		rows = append(rows, schemes.ImportRow{
+			Line:        line,
+			Word:        field(record, r.word),
+			Translation: field(record, r.translation),
+			Tags:        splitTags(field(record, r.tags)),
+		})
+	}
+	return rows, nil
+}
+
+func field(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return record[index]
+}
+
+// splitTags splits tags separated by commas, semicolons or spaces
+func splitTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ',' || r == ';' || unicode.IsSpace(r)
+	})
+}
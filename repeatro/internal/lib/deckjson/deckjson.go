@@ -0,0 +1,244 @@
+// Package deckjson is the native format of deck backups: a JSON document with
+// the deck, its options and cards with tags, optionally with scheduling state
+// and review history.
+//
+// Documents carry Version. Adding optional fields keeps the version, so older
+// readers ignore them. Renaming, removing or changing meaning of a field bumps
+// it, and Decode keeps reading every older version, upgrading it to the current
+// one. Documents of a newer version are rejected
+package deckjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	modelCard "github.com/GOeda-Co/proto-contract/model/card"
+	modelDeck "github.com/GOeda-Co/proto-contract/model/deck"
+	schemes "github.com/GOeda-Co/proto-contract/scheme/card"
+	"github.com/google/uuid"
+)
+
+const (
+	Format  = "repeatro.deck"
+	Version = 1
+)
+
+var (
+	ErrNotDeck       = errors.New("document is not a repeatro deck")
+	ErrNewerVersion  = fmt.Errorf("document is of a newer version, up to %d is supported", Version)
+	ErrDeckNameEmpty = errors.New("deck name is required")
+)
+
+type Document struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	Deck       Deck      `json:"deck"`
+	Cards      []Card    `json:"cards"`
+}
+
+type Deck struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	IsPublic    bool     `json:"is_public,omitempty"`
+	Options     *Options `json:"options,omitempty"`
+}
+
+// Options are study settings of the deck, without what belongs to one installation
+type Options struct {
+	LearningSteps      []int64 `json:"learning_steps"`   // in minutes
+	RelearningSteps    []int64 `json:"relearning_steps"` // in minutes
+	GraduatingInterval int     `json:"graduating_interval"`
+	EasyInterval       int     `json:"easy_interval"`
+	MaximumInterval    int     `json:"maximum_interval"`
+	NewPerDay          int     `json:"new_per_day"`
+	MaxReviewsPerDay   int     `json:"max_reviews_per_day"`
+	Algorithm          string  `json:"algorithm,omitempty"`
+	BurySiblings       bool    `json:"bury_siblings,omitempty"`
+	NewCardOrder       string  `json:"new_card_order,omitempty"`
+}
+
+type Card struct {
+	Word        string                  `json:"word"`
+	Translation string                  `json:"translation"`
+	Tags        []string                `json:"tags,omitempty"`
+	Schedule    *schemes.ImportSchedule `json:"schedule,omitempty"` // absent for new cards or when exported without scheduling
+	Reviews     []Review                `json:"reviews,omitempty"`
+}
+
+// Review is one answer to the card, with state of the card before it
+type Review struct {
+	Grade      int       `json:"grade"`
+	ReviewedAt time.Time `json:"reviewed_at"`
+	Phase      string    `json:"phase"`
+	Interval   int       `json:"interval"`
+	Easiness   float64   `json:"easiness"`
+	Stability  float64   `json:"stability,omitempty"`
+	Difficulty float64   `json:"difficulty,omitempty"`
+}
+
+// New makes a document of the deck. Scheduling state is kept when schedule is set,
+// reviews are kept when given, they are matched to cards by card id
+func New(deck modelDeck.Deck, options *modelDeck.Options, cards []modelCard.Card, schedule bool, reviews []schemes.Review) Document {
+	doc := Document{
+		Format:     Format,
+		Version:    Version,
+		ExportedAt: time.Now().UTC(),
+		Deck: Deck{
+			Name:        deck.Name,
+			Description: deck.Description,
+			IsPublic:    deck.IsPublic,
+			Options:     fromOptions(options),
+		},
+		Cards: make([]Card, 0, len(cards)),
+	}
+
+	history := make(map[string][]Review)
+	for _, review := range reviews {
+		cardId := review.CardId.String()
+		history[cardId] = append(history[cardId], Review{
+			Grade:      review.Grade,
+			ReviewedAt: review.ReviewedAt,
+			Phase:      review.Phase,
+			Interval:   review.Interval,
+			Easiness:   review.Easiness,
+			Stability:  review.Stability,
+			Difficulty: review.Difficulty,
+		})
+	}
+
+	for _, card := range cards {
+		exported := Card{
+			Word:        card.Word,
+			Translation: card.Translation,
+			Tags:        card.Tags,
+			Reviews:     history[card.CardId.String()],
+		}
+		if schedule && card.Phase != "" && card.Phase != "new" {
+			exported.Schedule = &schemes.ImportSchedule{
+				Phase:            card.Phase,
+				Interval:         card.Interval,
+				Easiness:         card.Easiness,
+				RepetitionNumber: card.RepetitionNumber,
+				Lapses:           card.Lapses,
+				ExpiresAt:        card.ExpiresAt,
+				LastReviewedAt:   card.LastReviewedAt,
+				Stability:        card.Stability,
+				Difficulty:       card.Difficulty,
+			}
+		}
+		doc.Cards = append(doc.Cards, exported)
+	}
+	return doc
+}
+
+func fromOptions(options *modelDeck.Options) *Options {
+	if options == nil {
+		return nil
+	}
+	return &Options{
+		LearningSteps:      options.LearningSteps,
+		RelearningSteps:    options.RelearningSteps,
+		GraduatingInterval: options.GraduatingInterval,
+		EasyInterval:       options.EasyInterval,
+		MaximumInterval:    options.MaximumInterval,
+		NewPerDay:          options.NewPerDay,
+		MaxReviewsPerDay:   options.MaxReviewsPerDay,
+		Algorithm:          options.Algorithm,
+		BurySiblings:       options.BurySiblings,
+		NewCardOrder:       options.NewCardOrder,
+	}
+}
+
+// Decode reads a document of any supported version
+func Decode(r io.Reader) (*Document, error) {
+	var doc Document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc.Format != Format || doc.Version < 1 {
+		return nil, ErrNotDeck
+	}
+	if doc.Version > Version {
+		return nil, ErrNewerVersion
+	}
+	if doc.Deck.Name == "" {
+		return nil, ErrDeckNameEmpty
+	}
+	return &doc, nil
+}
+
+// ModelDeck is the deck to create for the document
+func (d *Document) ModelDeck() modelDeck.Deck {
+	return modelDeck.Deck{
+		Name:        d.Deck.Name,
+		Description: d.Deck.Description,
+		IsPublic:    d.Deck.IsPublic,
+	}
+}
+
+// ModelOptions are options of the document for the deck, nil if it has none
+func (d *Document) ModelOptions(deckId uuid.UUID) *modelDeck.Options {
+	options := d.Deck.Options
+	if options == nil {
+		return nil
+	}
+	return &modelDeck.Options{
+		DeckId:             deckId,
+		LearningSteps:      options.LearningSteps,
+		RelearningSteps:    options.RelearningSteps,
+		GraduatingInterval: options.GraduatingInterval,
+		EasyInterval:       options.EasyInterval,
+		MaximumInterval:    options.MaximumInterval,
+		NewPerDay:          options.NewPerDay,
+		MaxReviewsPerDay:   options.MaxReviewsPerDay,
+		Algorithm:          options.Algorithm,
+		BurySiblings:       options.BurySiblings,
+		NewCardOrder:       options.NewCardOrder,
+	}
+}
+
+// Rows returns a reader of cards as rows to import, Line of a row is the number of the card
+func (d *Document) Rows() *Reader {
+	return &Reader{cards: d.Cards}
+}
+
+type Reader struct {
+	cards []Card
+	next  int
+}
+
+// Next returns up to n rows and io.EOF once all cards are read
+func (r *Reader) Next(n int) ([]schemes.ImportRow, error) {
+	if r.next >= len(r.cards) {
+		return nil, io.EOF
+	}
+	end := min(r.next+n, len(r.cards))
+	rows := make([]schemes.ImportRow, 0, end-r.next)
+	for i, card := range r.cards[r.next:end] {
+		row := schemes.ImportRow{
+			Line:        r.next + i + 1,
+			Word:        card.Word,
+			Translation: card.Translation,
+			Tags:        card.Tags,
+			Schedule:    card.Schedule,
+		}
+		for _, review := range card.Reviews {
+			row.Reviews = append(row.Reviews, schemes.Review{
+				Grade:      review.Grade,
+				ReviewedAt: review.ReviewedAt,
+				Phase:      review.Phase,
+				Interval:   review.Interval,
+				Easiness:   review.Easiness,
+				Stability:  review.Stability,
+				Difficulty: review.Difficulty,
+			})
+		}
+		rows = append(rows, row)
+	}
+	r.next = end
+	return rows, nil
+}
@@ -45,3 +45,5 @@ require (
 	google.golang.org/protobuf v1.36.6 // indirect
 	olympos.io/encoding/edn v0.0.0-20201019073823-d3554ca0b0a3 // indirect
 )
+
+replace github.com/GOeda-Co/proto-contract => ../proto-contract
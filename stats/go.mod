@@ -3,8 +3,9 @@ module github.com/tomatoCoderq/stats
 go 1.24.0
 
 require (
-	github.com/GOeda-Co/proto-contract v0.4.6
+	github.com/GOeda-Co/proto-contract v0.5.0
 	github.com/ilyakaznacheev/cleanenv v1.5.0
+	google.golang.org/protobuf v1.36.6
 	gorm.io/driver/postgres v1.6.0
 	gorm.io/gorm v1.30.1
 )
@@ -46,7 +47,6 @@ require (
 	golang.org/x/sys v0.34.0 // indirect
 	golang.org/x/text v0.27.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250728155136-f173205681a0 // indirect
-	google.golang.org/protobuf v1.36.6 // indirect
 )
 
 require (
@@ -62,3 +62,5 @@ require (
 	gopkg.in/yaml.v3 v3.0.1
 	olympos.io/encoding/edn v0.0.0-20201019073823-d3554ca0b0a3 // indirect
 )
+
+replace github.com/GOeda-Co/proto-contract => ../proto-contract
@@ -10,6 +10,7 @@ import (
 type Service interface {
 	GetAverageGrade(uid, deckId string, timeRange statsv1.TimeRange) (float64, error)
 	GetCardsReviewedCount(uid, deckId string, timeRange statsv1.TimeRange) (int32, error)
-	AddRecord(uid uuid.UUID, deckId, dardId string, CreatedAt time.Time, grade int) (string, error)
+	AddRecord(uid uuid.UUID, deckId, dardId string, CreatedAt time.Time, grade int, idempotencyKey string) (string, error)
+	DeleteRecord(uid uuid.UUID, reviewId string) error
 	// GetCardsLearnedCount(uid, deckId string, timeRange statsv1.TimeRange) (int32, error)
 }
@@ -7,12 +7,14 @@ import (
 
 	"github.com/tomatoCoderq/stats/internal/controller"
 	"github.com/tomatoCoderq/stats/internal/lib/security"
+	"github.com/tomatoCoderq/stats/internal/service/stats"
 
 	statsv1 "github.com/GOeda-Co/proto-contract/gen/go/stats"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type ServerAPI struct {
@@ -98,7 +100,7 @@ func (s *ServerAPI) AddRecording(ctx context.Context, in *statsv1.AddRecordingRe
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
 	}
 
-	reviewId, err := s.service.AddRecord(authUser.ID, in.DeckId, in.CardId, in.CreatedAt.AsTime(), int(in.Grade))
+	reviewId, err := s.service.AddRecord(authUser.ID, in.DeckId, in.CardId, in.CreatedAt.AsTime(), int(in.Grade), in.IdempotencyKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Error happened: %v", err))
 	}
@@ -108,6 +110,26 @@ func (s *ServerAPI) AddRecording(ctx context.Context, in *statsv1.AddRecordingRe
 	}, nil
 }
 
+func (s *ServerAPI) DeleteRecording(ctx context.Context, in *statsv1.DeleteRecordingRequest) (*emptypb.Empty, error) {
+	if in.ReviewId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ReviewId is required")
+	}
+
+	authUser, err := GetAuthUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to auth user: %v", err))
+	}
+
+	if err := s.service.DeleteRecord(authUser.ID, in.ReviewId); err != nil {
+		if errors.Is(err, stats.ErrReviewNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Error happened: %v", err))
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // GetCardsLearnedCount returns how many cards the user learned in a given time range and optional deck
 // func (s *ServerAPI) GetCardsLearnedCount(ctx context.Context, req *statsv1.GetCardsLearnedCountRequest) (*statsv1.GetCardsLearnedCountResponse, error) {
 // 	// TODO: implement logic to count "learned" cards (e.g., status or easiness threshold)
@@ -10,12 +10,14 @@ import (
 	// "github.com/tomatoCoderq/stats/pkg/model/review"
 	model "github.com/GOeda-Co/proto-contract/model/review"
 
+	"github.com/tomatoCoderq/stats/internal/service/stats"
 	"github.com/tomatoCoderq/stats/migrations"
 
 	// "github.com/tomatoCoderq/card/pkg/scheme"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // type Repository interface {
@@ -98,18 +100,31 @@ func (cr Repository) CountReviewedCards(uid, deckId uuid.UUID, startTime, endTim
 	return int32(len(reviews)), nil
 }
 
-func (cr Repository) AddRecord(uid, deckId, cardId uuid.UUID, createdAt time.Time, grade int) (string, error) {
+// AddRecord stores the review, a review with already stored reviewId is left untouched
+func (cr Repository) AddRecord(uid, deckId, cardId uuid.UUID, createdAt time.Time, grade int, reviewId uuid.UUID) (string, error) {
 	// var review model.Review
 	review := model.Review{
+		ResultId:  reviewId,
 		UserID:    uid,
 		DeckId:    deckId,
 		CardID:    cardId,
 		CreatedAt: createdAt,
 		Grade:     int32(grade),
 	}
-	if err := cr.db.Create(&review).Error; err != nil {
+	if err := cr.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&review).Error; err != nil {
 		return "", err
 	}
 
 	return review.ResultId.String(), nil
 }
+
+func (cr Repository) DeleteRecord(uid, reviewId uuid.UUID) error {
+	result := cr.db.Where("result_id = ? AND user_id = ?", reviewId, uid).Delete(&model.Review{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return stats.ErrReviewNotFound
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 )
 
+var ErrReviewNotFound = errors.New("review not found")
+
 func calculateTimeRange(tr statsv1.TimeRange) (start, end time.Time) {
 	now := time.Now()
 	switch tr {
@@ -30,7 +32,8 @@ func calculateTimeRange(tr statsv1.TimeRange) (start, end time.Time) {
 type Repository interface {
 	AverageGrade(uid, deckId uuid.UUID, startTime, endTime time.Time) (float64, error)
 	CountReviewedCards(uid, deckId uuid.UUID, startTime, endTime time.Time) (int32, error)
-	AddRecord(uid, deckId, cardId uuid.UUID, createdAt time.Time, grade int) (string, error)
+	AddRecord(uid, deckId, cardId uuid.UUID, createdAt time.Time, grade int, reviewId uuid.UUID) (string, error)
+	DeleteRecord(uid, reviewId uuid.UUID) error
 	// GetCardsLearnedCount(uid, cardId string, startTime, endTime time.Time) (int32, error)
 }
 
@@ -110,7 +113,9 @@ func (s *Service) GetCardsReviewedCount(uid, deckId string, timeRange statsv1.Ti
 	return count, nil
 }
 
-func (s *Service) AddRecord(uid uuid.UUID, deckId, cardId string, createdAt time.Time, grade int) (string, error) {
+// AddRecord stores the review. Non-empty idempotency key is used as review id,
+// so the same review delivered twice is stored once
+func (s *Service) AddRecord(uid uuid.UUID, deckId, cardId string, createdAt time.Time, grade int, idempotencyKey string) (string, error) {
 	var err error
 	var deckIdParsed uuid.UUID
 
@@ -125,7 +130,7 @@ func (s *Service) AddRecord(uid uuid.UUID, deckId, cardId string, createdAt time
 
 	var cardIdParsed uuid.UUID
 	if cardId != "" {
-		cardIdParsed, err = uuid.Parse(deckId)
+		cardIdParsed, err = uuid.Parse(cardId)
 		if err != nil {
 			return "", fmt.Errorf("failed during parsing uid")
 		}
@@ -133,12 +138,23 @@ func (s *Service) AddRecord(uid uuid.UUID, deckId, cardId string, createdAt time
 		cardIdParsed = uuid.UUID{}
 	}
 
-	fmt.Println("QWE", deckIdParsed, cardIdParsed)
+	var reviewId uuid.UUID
+	if idempotencyKey != "" {
+		reviewId, err = uuid.Parse(idempotencyKey)
+		if err != nil {
+			return "", fmt.Errorf("failed during parsing idempotency key")
+		}
+	}
+
+	return s.repo.AddRecord(uid, deckIdParsed, cardIdParsed, createdAt, grade, reviewId)
+}
 
-	reviewId, err := s.repo.AddRecord(uid, deckIdParsed, cardIdParsed, createdAt, grade)
+// DeleteRecord removes review of the user. Reviews of other users are reported as not found
+func (s *Service) DeleteRecord(uid uuid.UUID, reviewId string) error {
+	reviewIdParsed, err := uuid.Parse(reviewId)
 	if err != nil {
-		return "", err
+		return fmt.Errorf("failed during parsing review id")
 	}
 
-	return reviewId, nil
+	return s.repo.DeleteRecord(uid, reviewIdParsed)
 }